@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// discover finds the existing resources in an AWS account and writes Terraform import blocks for them,
+// from which `terraform plan -generate-config-out` generates configuration.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/discovery"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+var (
+	failFast           = flag.Bool("fail-fast", false, "Stop discovery at the first sweeper failure")
+	filter             = flag.String("filter", "", "Comma-separated list of resource types (or parts of types) to discover. All are discovered if empty")
+	offlineCassetteDir = flag.String("offline-cassette-dir", "", "Serve AWS API responses from the recorded cassettes in this directory rather than calling AWS")
+	output             = flag.String("out", "", "File to write import blocks to. Defaults to standard output")
+	regions            = flag.String("region", "", "Comma-separated list of Regions to discover resources in")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tdiscover -region <regions> [-filter <resource-types>] [-out <file>]\n\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if *regions == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if v := *offlineCassetteDir; v != "" {
+		os.Setenv(conns.OfflineCassetteDirEnvVar, v)
+		// No requests are sent to AWS, and a custom CA bundle can't be added to the offline mode HTTP client.
+		os.Unsetenv("AWS_CA_BUNDLE")
+	}
+
+	runner := &sweep.Runner{
+		AllowFailures: !*failFast,
+		Filter:        *filter,
+	}
+
+	resources, err := discovery.Discover(context.Background(), runner, strings.Split(*regions, ","))
+	if err != nil {
+		if *failFast {
+			log.Fatalf("[ERROR] %s", err)
+		}
+		log.Printf("[WARN] %s", err)
+	}
+
+	var w io.WriteCloser = os.Stdout
+	if v := *output; v != "" {
+		w, err = os.Create(v)
+		if err != nil {
+			log.Fatalf("[ERROR] creating %s: %s", v, err)
+		}
+	}
+
+	if err := discovery.WriteImports(w, resources); err != nil {
+		log.Fatalf("[ERROR] writing import blocks: %s", err)
+	}
+
+	if err := w.Close(); err != nil {
+		log.Fatalf("[ERROR] writing import blocks: %s", err)
+	}
+
+	log.Printf("Discovered %d resources. Generate their configuration with `terraform plan -generate-config-out=generated.tf`", len(resources))
+}
@@ -9,6 +9,7 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -16,6 +17,7 @@ import (
 	config_sdkv2 "github.com/aws/aws-sdk-go-v2/config"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	s3_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -27,9 +29,9 @@ import (
 type AWSClient struct {
 	AccountID         string
 	defaultTagsConfig *tftags.DefaultConfig
+	DeletionPolicy    *DeletionPolicy
 	IgnoreTagsConfig  *tftags.IgnoreConfig
 	Partition         string
-	Region            string
 	ServicePackages   map[string]ServicePackage
 
 	awsConfig                 *aws_sdkv2.Config
@@ -40,11 +42,13 @@ type AWSClient struct {
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	region                    string
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3_sdkv2.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	s3UsePathStyle            bool                           // From provider configuration.
+	s3USEast1RegionalEndpoint string                         // From provider configuration.
+	serviceRateLimiters       map[string]*serviceRateLimiter // From provider configuration.
+	stsRegion                 string                         // From provider configuration.
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -63,6 +67,42 @@ func (c *AWSClient) AwsConfig(context.Context) aws_sdkv2.Config { // nosemgrep:c
 	return c.awsConfig.Copy()
 }
 
+// Region returns the AWS Region used for API calls made with the specified Context.
+// This is the value of the resource's `region` argument, if set, otherwise the provider's configured Region.
+func (c *AWSClient) Region(ctx context.Context) string {
+	if v := overrideRegion(ctx); v != "" {
+		return v
+	}
+	return c.region
+}
+
+// SetRegion sets the provider's configured AWS Region.
+func (c *AWSClient) SetRegion(_ context.Context, region string) {
+	c.region = region
+}
+
+// overrideRegion returns any per-resource Region override kept in Context.
+func overrideRegion(ctx context.Context) string {
+	if v, ok := FromContext(ctx); ok {
+		return v.OverrideRegion
+	}
+	return ""
+}
+
+// ValidateOverrideRegion returns an error if the specified per-resource Region override is not in the provider's configured partition.
+// The provider's partition, DNS suffix and credentials are used for all API calls, ARNs and hostnames so Regions in other partitions cannot be used.
+func (c *AWSClient) ValidateOverrideRegion(_ context.Context, region string) error {
+	if region == "" || c.Partition == "" {
+		return nil
+	}
+
+	if partition := names.PartitionForRegion(region); partition != c.Partition {
+		return fmt.Errorf("Region (%s) is in partition (%s), not the provider's configured partition (%s)", region, partition, c.Partition)
+	}
+
+	return nil
+}
+
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
 // e.g. PREFIX.amazonaws.com
 // The prefix should not contain a trailing period.
@@ -74,7 +114,7 @@ func (c *AWSClient) PartitionHostname(ctx context.Context, prefix string) string
 // e.g. PREFIX.us-west-2.amazonaws.com
 // The prefix should not contain a trailing period.
 func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string {
-	return fmt.Sprintf("%s.%s.%s", prefix, c.Region, c.DNSSuffix(ctx))
+	return fmt.Sprintf("%s.%s.%s", prefix, c.Region(ctx), c.DNSSuffix(ctx))
 }
 
 // S3ExpressClient returns an AWS SDK for Go v2 S3 API client suitable for use with S3 Express (directory buckets).
@@ -83,6 +123,11 @@ func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string
 func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3_sdkv2.Client {
 	s3Client := c.S3Client(ctx)
 
+	// Clients for overridden Regions always use the regional S3 endpoint.
+	if c.Region(ctx) != c.region {
+		return s3Client
+	}
+
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
@@ -190,8 +235,8 @@ func (c *AWSClient) ReverseDNSPrefix(ctx context.Context) string {
 }
 
 // EC2RegionalPrivateDNSSuffix returns the EC2 private DNS suffix for the configured AWS Region.
-func (c *AWSClient) EC2RegionalPrivateDNSSuffix(context.Context) string {
-	region := c.Region
+func (c *AWSClient) EC2RegionalPrivateDNSSuffix(ctx context.Context) string {
+	region := c.Region(ctx)
 	if region == names.USEast1RegionID {
 		return "ec2.internal"
 	}
@@ -200,8 +245,8 @@ func (c *AWSClient) EC2RegionalPrivateDNSSuffix(context.Context) string {
 }
 
 // EC2RegionalPublicDNSSuffix returns the EC2 public DNS suffix for the configured AWS Region.
-func (c *AWSClient) EC2RegionalPublicDNSSuffix(context.Context) string {
-	region := c.Region
+func (c *AWSClient) EC2RegionalPublicDNSSuffix(ctx context.Context) string {
+	region := c.Region(ctx)
 	if region == names.USEast1RegionID {
 		return "compute-1"
 	}
@@ -231,6 +276,24 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"partition":        c.Partition,
 		"session":          c.session,
 	}
+	if region := c.Region(ctx); region != c.region {
+		if c.awsConfig != nil {
+			awsConfig := c.awsConfig.Copy()
+			awsConfig.Region = region
+			m["aws_sdkv2_config"] = &awsConfig
+		}
+		if c.session != nil {
+			m["session"] = c.session.Copy(&aws_sdkv1.Config{Region: aws_sdkv1.String(region)})
+		}
+	}
+	// Per-service rate limits apply to AWS SDK for Go v2 API clients only.
+	if l, ok := c.serviceRateLimiters[servicePackageName]; ok {
+		if v, ok := m["aws_sdkv2_config"].(*aws_sdkv2.Config); ok && v != nil {
+			awsConfig := v.Copy()
+			awsConfig.APIOptions = append(slices.Clone(awsConfig.APIOptions), l.apiOption)
+			m["aws_sdkv2_config"] = &awsConfig
+		}
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
@@ -271,19 +334,29 @@ func resolveServiceBaseEndpoint(ctx context.Context, sdkID string, configs []any
 	return
 }
 
+// apiClientCacheKey returns the key used to cache the default API client for the specified service.
+// Clients for per-resource Region overrides are cached separately from those for the provider's configured Region.
+func apiClientCacheKey(ctx context.Context, c *AWSClient, servicePackageName string) string {
+	if region := c.Region(ctx); region != c.region {
+		return servicePackageName + "@" + region
+	}
+	return servicePackageName
+}
+
 // conn returns the AWS SDK for Go v1 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) is cached per Region. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
 	isDefault := len(extra) == 0
+	key := apiClientCacheKey(ctx, c, servicePackageName)
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.conns[servicePackageName]; ok {
+		if raw, ok := c.conns[key]; ok {
 			if conn, ok := raw.(T); ok {
 				return conn, nil
 			} else {
@@ -327,25 +400,26 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 
 	// Default service client is cached.
 	if isDefault {
-		c.conns[servicePackageName] = conn
+		c.conns[key] = conn
 	}
 
 	return conn, nil
 }
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) is cached per Region. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
 	isDefault := len(extra) == 0
+	key := apiClientCacheKey(ctx, c, servicePackageName)
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[key]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -380,7 +454,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		c.clients[key] = client
 	}
 
 	return client, nil
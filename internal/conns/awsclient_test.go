@@ -6,6 +6,8 @@ package conns
 import (
 	"context"
 	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -63,7 +65,7 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 			Name: "AWS Commercial",
 			AWSClient: &AWSClient{
 				dnsSuffix: "amazonaws.com",
-				Region:    "us-west-2", //lintignore:AWSAT003
+				region:    "us-west-2", //lintignore:AWSAT003
 			},
 			Prefix:   "test",
 			Expected: "test.us-west-2.amazonaws.com", //lintignore:AWSAT003
@@ -72,7 +74,7 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 			Name: "AWS China",
 			AWSClient: &AWSClient{
 				dnsSuffix: "amazonaws.com.cn",
-				Region:    "cn-northwest-1", //lintignore:AWSAT003
+				region:    "cn-northwest-1", //lintignore:AWSAT003
 			},
 			Prefix:   "test",
 			Expected: "test.cn-northwest-1.amazonaws.com.cn", //lintignore:AWSAT003
@@ -106,7 +108,7 @@ func TestAWSClientEC2PrivateDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-
 			Name: "us-west-2",
 			AWSClient: &AWSClient{
 				dnsSuffix: "amazonaws.com",
-				Region:    "us-west-2", //lintignore:AWSAT003
+				region:    "us-west-2", //lintignore:AWSAT003
 			},
 			IP:       "10.20.30.40",
 			Expected: "ip-10-20-30-40.us-west-2.compute.internal", //lintignore:AWSAT003
@@ -115,7 +117,7 @@ func TestAWSClientEC2PrivateDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-
 			Name: "us-east-1",
 			AWSClient: &AWSClient{
 				dnsSuffix: "amazonaws.com",
-				Region:    "us-east-1", //lintignore:AWSAT003
+				region:    "us-east-1", //lintignore:AWSAT003
 			},
 			IP:       "10.20.30.40",
 			Expected: "ip-10-20-30-40.ec2.internal",
@@ -149,7 +151,7 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 			Name: "us-west-2",
 			AWSClient: &AWSClient{
 				dnsSuffix: "amazonaws.com",
-				Region:    "us-west-2", //lintignore:AWSAT003
+				region:    "us-west-2", //lintignore:AWSAT003
 			},
 			IP:       "10.20.30.40",
 			Expected: "ec2-10-20-30-40.us-west-2.compute.amazonaws.com", //lintignore:AWSAT003
@@ -158,7 +160,7 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 			Name: "us-east-1",
 			AWSClient: &AWSClient{
 				dnsSuffix: "amazonaws.com",
-				Region:    "us-east-1", //lintignore:AWSAT003
+				region:    "us-east-1", //lintignore:AWSAT003
 			},
 			IP:       "10.20.30.40",
 			Expected: "ec2-10-20-30-40.compute-1.amazonaws.com",
@@ -177,3 +179,93 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAWSClientRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Context  func(context.Context) context.Context
+		Expected string
+	}{
+		{
+			Name: "no resource Context",
+			Context: func(ctx context.Context) context.Context {
+				return ctx
+			},
+			Expected: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name: "no override",
+			Context: func(ctx context.Context) context.Context {
+				return NewResourceContext(ctx, "test", "Test", "aws_test")
+			},
+			Expected: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name: "override",
+			Context: func(ctx context.Context) context.Context {
+				ctx = NewResourceContext(ctx, "test", "Test", "aws_test")
+				v, _ := FromContext(ctx)
+				v.OverrideRegion = "eu-central-1" //lintignore:AWSAT003
+
+				return ctx
+			},
+			Expected: "eu-central-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &AWSClient{
+				dnsSuffix: "amazonaws.com",
+				region:    "us-west-2", //lintignore:AWSAT003
+			}
+			ctx := testCase.Context(context.TODO())
+
+			if got, want := client.Region(ctx), testCase.Expected; got != want {
+				t.Errorf("got %s, expected %s", got, want)
+			}
+			if got, want := apiClientCacheKey(ctx, client, "test") == "test", testCase.Expected == client.region; got != want {
+				t.Errorf("got default cache key %t, expected %t", got, want)
+			}
+		})
+	}
+}
+
+func TestAWSClientValidateOverrideRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := map[string]struct {
+		region      string
+		expectError bool
+	}{
+		"no override": {},
+		"same partition": {
+			region: "eu-central-1", //lintignore:AWSAT003
+		},
+		"other partition": {
+			region:      "cn-north-1", //lintignore:AWSAT003
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &AWSClient{
+				Partition: names.StandardPartitionID,
+				region:    "us-west-2", //lintignore:AWSAT003
+			}
+
+			err := client.ValidateOverrideRegion(context.TODO(), testCase.region)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("got error %v, expected error %t", err, want)
+			}
+		})
+	}
+}
@@ -6,11 +6,13 @@ package conns
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/aws/smithy-go/middleware"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
@@ -22,17 +24,27 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
 
+const (
+	// OfflineCassetteDirEnvVar is the environment variable that sets the directory of recorded cassettes
+	// used in offline mode if the `offline_cassette_dir` provider argument is not configured.
+	OfflineCassetteDirEnvVar = "TF_AWS_OFFLINE_CASSETTE_DIR"
+)
+
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	APICallTelemetryFile           string
+	APIOptions                     []func(*middleware.Stack) error
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DeletionPolicy                 *DeletionPolicy
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
@@ -44,12 +56,14 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	NoProxy                        string
+	OfflineCassetteDir             string
 	Profile                        string
 	Region                         string
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceRateLimits              map[string]ServiceRateLimit
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -136,6 +150,26 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		awsbaseConfig.StsRegion = c.STSRegion
 	}
 
+	if c.OfflineCassetteDir != "" {
+		// Serve AWS API responses from recorded cassettes. No request is sent to AWS.
+		transport, err := vcr.NewReplayTransport(c.OfflineCassetteDir)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "configuring offline mode: %s", err)
+		}
+
+		awsbaseConfig.HTTPClient = &http.Client{Transport: transport}
+		// Replayed responses never change, so don't retry.
+		awsbaseConfig.MaxRetries = 1
+		// Credentials are only used to sign requests, which are matched without their headers.
+		awsbaseConfig.AccessKey = "offline"
+		awsbaseConfig.SecretKey = "offline"
+		awsbaseConfig.Token = ""
+		awsbaseConfig.Profile = ""
+		awsbaseConfig.AssumeRole = nil
+		awsbaseConfig.AssumeRoleWithWebIdentity = nil
+		awsbaseConfig.EC2MetadataServiceEnableState = imds_sdkv2.ClientDisabled
+	}
+
 	// Avoid duplicate calls to STS by enabling SkipCredsValidation for the call to GetAwsConfig
 	// and then restoring the configured value for the call to GetAwsAccountIDAndPartition.
 	skipCredsValidation := awsbaseConfig.SkipCredsValidation
@@ -208,14 +242,19 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	client.AccountID = accountID
 	client.defaultTagsConfig = c.DefaultTagsConfig
+	client.DeletionPolicy = c.DeletionPolicy
 	client.dnsSuffix = dnsSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
-	client.Region = c.Region
+	client.region = c.Region
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
 	// Used for lazy-loading AWS API clients.
+	cfg.APIOptions = append(cfg.APIOptions, c.APIOptions...)
+	if c.APICallTelemetryFile != "" {
+		cfg.APIOptions = append(cfg.APIOptions, apiCallTelemetryFor(c.APICallTelemetryFile).apiOption)
+	}
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
@@ -223,6 +262,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.serviceRateLimiters = newServiceRateLimiters(c.ServiceRateLimits)
 	client.stsRegion = c.STSRegion
 
 	return client, diags
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"fmt"
+	"path"
+	"slices"
+)
+
+// DeletionPolicy is the provider's resource deletion policy.
+// It denies the deletion of any resource that matches one or more of its selectors.
+type DeletionPolicy struct {
+	// ARNs are the ARNs of resources whose deletion is denied.
+	ARNs []string
+	// ResourceTypes are patterns matching the types of resources whose deletion is denied.
+	// Patterns may contain `*` and `?` wildcards, e.g. `aws_db_*`.
+	ResourceTypes []string
+	// Tags are the tags of resources whose deletion is denied.
+	// A value of `*` matches any value of the tag.
+	Tags map[string]string
+}
+
+// Denies returns whether the policy denies deletion of the resource of the specified type with the specified ARN and tags.
+// The ARN is empty if the resource has no ARN. If deletion is denied, the returned string describes the matching selector.
+func (p *DeletionPolicy) Denies(resourceType, arn string, tags map[string]string) (string, bool) {
+	if p == nil {
+		return "", false
+	}
+
+	for _, pattern := range p.ResourceTypes {
+		if ok, _ := path.Match(pattern, resourceType); ok {
+			return fmt.Sprintf("resource type matches %q", pattern), true
+		}
+	}
+
+	if arn != "" && slices.Contains(p.ARNs, arn) {
+		return fmt.Sprintf("ARN is %q", arn), true
+	}
+
+	for k, want := range p.Tags {
+		if got, ok := tags[k]; ok && (want == "*" || got == want) {
+			return fmt.Sprintf("tag %q is %q", k, got), true
+		}
+	}
+
+	return "", false
+}
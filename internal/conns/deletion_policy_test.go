@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestDeletionPolicyDenies(t *testing.T) {
+	t.Parallel()
+
+	policy := &DeletionPolicy{
+		ARNs:          []string{"arn:aws:s3:::example"}, //lintignore:AWSAT005
+		ResourceTypes: []string{"aws_db_*", "aws_dynamodb_table"},
+		Tags: map[string]string{
+			"Environment": "production",
+			"Protected":   "*",
+		},
+	}
+
+	testCases := map[string]struct {
+		policy       *DeletionPolicy
+		resourceType string
+		arn          string
+		tags         map[string]string
+		expected     bool
+	}{
+		"no policy": {
+			resourceType: "aws_db_instance",
+		},
+		"no match": {
+			policy:       policy,
+			resourceType: "aws_s3_bucket",
+			arn:          "arn:aws:s3:::other", //lintignore:AWSAT005
+			tags:         map[string]string{"Environment": "test"},
+		},
+		"resource type wildcard": {
+			policy:       policy,
+			resourceType: "aws_db_instance",
+			expected:     true,
+		},
+		"resource type exact": {
+			policy:       policy,
+			resourceType: "aws_dynamodb_table",
+			expected:     true,
+		},
+		"resource type prefix": {
+			policy:       policy,
+			resourceType: "aws_dynamodb_table_item",
+		},
+		"ARN": {
+			policy:       policy,
+			resourceType: "aws_s3_bucket",
+			arn:          "arn:aws:s3:::example", //lintignore:AWSAT005
+			expected:     true,
+		},
+		"tag value": {
+			policy:       policy,
+			resourceType: "aws_s3_bucket",
+			tags:         map[string]string{"Environment": "production"},
+			expected:     true,
+		},
+		"tag any value": {
+			policy:       policy,
+			resourceType: "aws_s3_bucket",
+			tags:         map[string]string{"Protected": ""},
+			expected:     true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			reason, got := testCase.policy.Denies(testCase.resourceType, testCase.arn, testCase.tags)
+
+			if want := testCase.expected; got != want {
+				t.Errorf("Denies = %t, want %t", got, want)
+			}
+			if got && reason == "" {
+				t.Error("expected reason, got none")
+			}
+		})
+	}
+}
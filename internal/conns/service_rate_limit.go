@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+// ServiceRateLimit is a client-side limit on the AWS API calls made to a single service.
+// It is applied in addition to the AWS SDK's retry token bucket.
+type ServiceRateLimit struct {
+	// RequestsPerSecond is the sustained rate of API requests. Zero means no limit.
+	RequestsPerSecond float64
+	// Burst is the maximum number of API requests made at once above the sustained rate.
+	// Zero means a burst of one request.
+	Burst int
+	// MaxConcurrency is the maximum number of in-flight API requests. Zero means no limit.
+	MaxConcurrency int
+}
+
+// serviceRateLimiter implements a ServiceRateLimit as a token bucket and a semaphore.
+// It is shared by all the service's API clients created from the same provider configuration.
+type serviceRateLimiter struct {
+	concurrency chan struct{} // nil if concurrency is unlimited.
+
+	lock   sync.Mutex
+	rate   float64 // Tokens per second. Zero if the request rate is unlimited.
+	burst  float64
+	tokens float64 // Negative when requests are waiting for tokens.
+	last   time.Time
+}
+
+func newServiceRateLimiter(limit ServiceRateLimit) *serviceRateLimiter {
+	l := &serviceRateLimiter{
+		rate:  limit.RequestsPerSecond,
+		burst: float64(max(limit.Burst, 1)),
+		last:  time.Now(),
+	}
+	l.tokens = l.burst
+
+	if limit.MaxConcurrency > 0 {
+		l.concurrency = make(chan struct{}, limit.MaxConcurrency)
+	}
+
+	return l
+}
+
+// newServiceRateLimiters returns rate limiters for the specified per-service limits.
+func newServiceRateLimiters(limits map[string]ServiceRateLimit) map[string]*serviceRateLimiter {
+	if len(limits) == 0 {
+		return nil
+	}
+
+	limiters := make(map[string]*serviceRateLimiter, len(limits))
+	for servicePackageName, limit := range limits {
+		limiters[servicePackageName] = newServiceRateLimiter(limit)
+	}
+
+	return limiters
+}
+
+// reserve takes a token from the bucket and returns how long to wait before the token is available.
+func (l *serviceRateLimiter) reserve(now time.Time) time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// wait blocks until an API request can be made.
+// A successful wait must be followed by a call to done once the request completes.
+func (l *serviceRateLimiter) wait(ctx context.Context) error {
+	if l.rate > 0 {
+		if d := l.reserve(time.Now()); d > 0 {
+			timer := time.NewTimer(d)
+			defer timer.Stop()
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+	}
+
+	if l.concurrency != nil {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case l.concurrency <- struct{}{}:
+		}
+	}
+
+	return nil
+}
+
+func (l *serviceRateLimiter) done() {
+	if l.concurrency != nil {
+		<-l.concurrency
+	}
+}
+
+// apiOption is AWS SDK for Go v2 API client middleware that applies the rate limit.
+func (l *serviceRateLimiter) apiOption(stack *middleware.Stack) error {
+	// Added after the SDK's retry middleware so that every attempt, including retries, is limited.
+	return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc("ServiceRateLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		if err := l.wait(ctx); err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, fmt.Errorf("waiting for service rate limit: %w", err)
+		}
+		defer l.done()
+
+		return next.HandleFinalize(ctx, in)
+	}), "Retry", middleware.After)
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	smithymiddleware "github.com/aws/smithy-go/middleware"
+)
+
+func TestServiceRateLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	l := newServiceRateLimiter(ServiceRateLimit{
+		RequestsPerSecond: 2,
+		Burst:             2,
+	})
+	now := l.last
+
+	testCases := []struct {
+		elapsed  time.Duration
+		expected time.Duration
+	}{
+		{0, 0},
+		{0, 0},
+		{0, 500 * time.Millisecond},
+		{0, time.Second},
+		{time.Second, 500 * time.Millisecond},
+		{10 * time.Second, 0},
+	}
+
+	for i, testCase := range testCases {
+		now = now.Add(testCase.elapsed)
+
+		if got, want := l.reserve(now), testCase.expected; got != want {
+			t.Errorf("reserve %d = %s, want %s", i, got, want)
+		}
+	}
+}
+
+func TestServiceRateLimiterMaxConcurrency(t *testing.T) {
+	t.Parallel()
+
+	const maxConcurrency = 2
+	l := newServiceRateLimiter(ServiceRateLimit{
+		MaxConcurrency: maxConcurrency,
+	})
+	ctx := context.Background()
+
+	var (
+		lock           sync.Mutex
+		inFlight, peak int
+		wg             sync.WaitGroup
+	)
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := l.wait(ctx); err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			defer l.done()
+
+			lock.Lock()
+			inFlight++
+			peak = max(peak, inFlight)
+			lock.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			lock.Lock()
+			inFlight--
+			lock.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if peak > maxConcurrency {
+		t.Errorf("peak concurrency = %d, want at most %d", peak, maxConcurrency)
+	}
+}
+
+func TestServiceRateLimiterWaitCanceled(t *testing.T) {
+	t.Parallel()
+
+	l := newServiceRateLimiter(ServiceRateLimit{
+		RequestsPerSecond: 0.001,
+		MaxConcurrency:    1,
+	})
+
+	if err := l.wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("wait = %v, want %s", err, context.DeadlineExceeded)
+	}
+}
+
+func TestServiceRateLimiterAPIOption(t *testing.T) {
+	t.Parallel()
+
+	const (
+		throttlingResponse = `<ErrorResponse><Error><Type>Sender</Type><Code>Throttling</Code><Message>Rate exceeded</Message></Error><RequestId>1</RequestId></ErrorResponse>`
+		successResponse    = `<GetCallerIdentityResponse><GetCallerIdentityResult><Arn>arn:aws:iam::123456789012:user/test</Arn><UserId>AIDACKCEVSQ6C2EXAMPLE</UserId><Account>123456789012</Account></GetCallerIdentityResult><ResponseMetadata><RequestId>2</RequestId></ResponseMetadata></GetCallerIdentityResponse>`
+	)
+
+	const burst = 10
+	l := newServiceRateLimiter(ServiceRateLimit{
+		RequestsPerSecond: 0.001,
+		Burst:             burst,
+		MaxConcurrency:    1,
+	})
+	client := sts.New(sts.Options{
+		APIOptions:  []func(*smithymiddleware.Stack) error{l.apiOption},
+		Credentials: credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		HTTPClient: &stubHTTPClient{
+			responses: []stubHTTPResponse{
+				{http.StatusBadRequest, throttlingResponse},
+				{http.StatusOK, successResponse},
+			},
+		},
+		Region: "us-west-2", //lintignore:AWSAT003
+		Retryer: retry.NewStandard(func(o *retry.StandardOptions) {
+			o.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) {
+				return 0, nil
+			})
+		}),
+	})
+
+	if _, err := client.GetCallerIdentity(context.Background(), &sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Each attempt takes a token.
+	if got, want := math.Floor(l.tokens), float64(burst-2); got != want {
+		t.Errorf("tokens = %v, want %v", got, want)
+	}
+	// The concurrency slot is released.
+	if got := len(l.concurrency); got != 0 {
+		t.Errorf("in-flight requests = %d, want 0", got)
+	}
+}
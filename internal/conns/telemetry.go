@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const (
+	// APICallTelemetryFileEnvVar is the environment variable that sets the path of the API call telemetry file
+	// if the `api_call_telemetry_file` provider argument is not configured.
+	APICallTelemetryFileEnvVar = "TF_AWS_API_CALL_TELEMETRY_FILE"
+)
+
+var (
+	// apiCallLatencyBucketsMS are the upper bounds, in milliseconds, of the API call latency histogram buckets.
+	// The final bucket, for calls slower than the last bound, is implicit.
+	apiCallLatencyBucketsMS = []int64{10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000, 30000}
+
+	// apiCallTelemetries holds this process's API call telemetry for each configured telemetry file.
+	// Each provider configuration, e.g. each alias, may be served by a separate provider process.
+	// Each process writes its own telemetry file at shutdown, see (*apiCallTelemetry).write.
+	apiCallTelemetries     = make(map[string]*apiCallTelemetry)
+	apiCallTelemetriesLock sync.Mutex
+)
+
+// apiCallTelemetryFor returns the API call telemetry recorder for the specified file.
+func apiCallTelemetryFor(path string) *apiCallTelemetry {
+	apiCallTelemetriesLock.Lock()
+	defer apiCallTelemetriesLock.Unlock()
+
+	t, ok := apiCallTelemetries[path]
+	if !ok {
+		t = newAPICallTelemetry()
+		apiCallTelemetries[path] = t
+	}
+
+	return t
+}
+
+// WriteAPICallTelemetry writes a JSON summary of the AWS API calls made by this provider process for each configured telemetry file.
+// It should be called at provider shutdown.
+func WriteAPICallTelemetry() error {
+	apiCallTelemetriesLock.Lock()
+	defer apiCallTelemetriesLock.Unlock()
+
+	var errs []error
+	for path, t := range apiCallTelemetries {
+		if err := t.write(path); err != nil {
+			errs = append(errs, fmt.Errorf("writing API call telemetry file (%s): %w", path, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// apiCallTelemetry records per-operation AWS API call statistics.
+type apiCallTelemetry struct {
+	lock sync.Mutex
+	// stats is keyed by Terraform resource type, Terraform operation and AWS API operation.
+	stats map[string]map[string]map[string]*apiCallStats
+}
+
+func newAPICallTelemetry() *apiCallTelemetry {
+	return &apiCallTelemetry{
+		stats: make(map[string]map[string]map[string]*apiCallStats),
+	}
+}
+
+type apiCallStats struct {
+	Calls     int                     `json:"calls"`
+	Errors    int                     `json:"errors"`
+	Attempts  int                     `json:"attempts"`
+	Retries   int                     `json:"retries"`
+	Throttles int                     `json:"throttles"`
+	LatencyMS apiCallLatencyHistogram `json:"latency_ms"`
+}
+
+type apiCallLatencyHistogram struct {
+	Total  int64   `json:"total"`
+	Max    int64   `json:"max"`
+	Bounds []int64 `json:"bounds"`
+	Counts []int   `json:"counts"`
+}
+
+func (h *apiCallLatencyHistogram) observe(d time.Duration) {
+	ms := d.Milliseconds()
+
+	if h.Counts == nil {
+		h.Bounds = apiCallLatencyBucketsMS
+		h.Counts = make([]int, len(apiCallLatencyBucketsMS)+1)
+	}
+
+	i := 0
+	for i < len(h.Bounds) && ms > h.Bounds[i] {
+		i++
+	}
+	h.Counts[i]++
+	h.Total += ms
+	h.Max = max(h.Max, ms)
+}
+
+// apiCallResult is the outcome of a single AWS API call, including any retries.
+type apiCallResult struct {
+	attempts  int
+	err       error
+	latency   time.Duration
+	retries   int
+	throttles int
+}
+
+// apiCallResultFromMetadata returns the outcome of an AWS API call from its middleware metadata.
+// Retry attempts are those decided on by the API client's retryer, including any added via AddIsErrorRetryables.
+func apiCallResultFromMetadata(metadata middleware.Metadata, err error, latency time.Duration) apiCallResult {
+	result := apiCallResult{
+		attempts: 1,
+		err:      err,
+		latency:  latency,
+	}
+
+	if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+		result.attempts = len(v.Results)
+		for _, attempt := range v.Results {
+			if attempt.Retried {
+				result.retries++
+			}
+			if attempt.Err != nil && retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(attempt.Err).Bool() {
+				result.throttles++
+			}
+		}
+	} else if err != nil && retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err).Bool() {
+		result.throttles++
+	}
+
+	return result
+}
+
+func (t *apiCallTelemetry) record(resourceType, terraformOperation, apiOperation string, result apiCallResult) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if _, ok := t.stats[resourceType]; !ok {
+		t.stats[resourceType] = make(map[string]map[string]*apiCallStats)
+	}
+	if _, ok := t.stats[resourceType][terraformOperation]; !ok {
+		t.stats[resourceType][terraformOperation] = make(map[string]*apiCallStats)
+	}
+	stats, ok := t.stats[resourceType][terraformOperation][apiOperation]
+	if !ok {
+		stats = &apiCallStats{}
+		t.stats[resourceType][terraformOperation][apiOperation] = stats
+	}
+
+	stats.Calls++
+	if result.err != nil {
+		stats.Errors++
+	}
+	stats.Attempts += result.attempts
+	stats.Retries += result.retries
+	stats.Throttles += result.throttles
+	stats.LatencyMS.observe(result.latency)
+}
+
+// write writes the recorded statistics to this process's telemetry file for the specified configured path.
+// Each provider process writes its own file, see apiCallTelemetryProcessFile, so no coordination between processes is needed
+// and the write completes well within the time Terraform allows a provider to shut down.
+func (t *apiCallTelemetry) write(path string) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	b, err := json.MarshalIndent(t.stats, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	path = apiCallTelemetryProcessFile(path, os.Getppid(), os.Getpid())
+
+	// Write to a temporary file and rename so that readers never see a partially written file.
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+// apiCallTelemetryProcessFile returns the path of a provider process's telemetry file.
+// The Terraform CLI's process ID (the provider's parent) and the provider's process ID are inserted before the file extension,
+// e.g. `telemetry.json` becomes `telemetry.1234.5678.json`.
+// The files written by the provider processes of a single Terraform command, e.g. one per alias, share the Terraform CLI's process ID.
+func apiCallTelemetryProcessFile(path string, ppid, pid int) string {
+	ext := filepath.Ext(path)
+
+	return fmt.Sprintf("%s.%d.%d%s", strings.TrimSuffix(path, ext), ppid, pid, ext)
+}
+
+// apiOption is AWS SDK for Go v2 API client middleware that records API call telemetry.
+func (t *apiCallTelemetry) apiOption(stack *middleware.Stack) error {
+	// Added after the SDK's own Initialize middleware so that the service and operation names are available.
+	// All retry attempts happen within the Finalize step.
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("APICallTelemetry", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		start := time.Now()
+		out, metadata, err := next.HandleInitialize(ctx, in)
+		result := apiCallResultFromMetadata(metadata, err, time.Since(start))
+
+		resourceType, terraformOperation := "provider", ""
+		if v, ok := FromContext(ctx); ok {
+			resourceType, terraformOperation = v.TypeName, v.TerraformOperation
+			if v.IsDataSource {
+				resourceType = "data." + resourceType
+			}
+		}
+		apiOperation := fmt.Sprintf("%s.%s", awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx))
+
+		t.record(resourceType, terraformOperation, apiOperation, result)
+
+		return out, metadata, err
+	}), middleware.After)
+}
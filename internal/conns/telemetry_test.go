@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	smithymiddleware "github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestAPICallTelemetry(t *testing.T) {
+	t.Parallel()
+
+	const (
+		throttlingResponse = `<ErrorResponse><Error><Type>Sender</Type><Code>Throttling</Code><Message>Rate exceeded</Message></Error><RequestId>1</RequestId></ErrorResponse>`
+		retryableResponse  = `<ErrorResponse><Error><Type>Sender</Type><Code>TestRetryable</Code><Message>testing</Message></Error><RequestId>2</RequestId></ErrorResponse>`
+		successResponse    = `<GetCallerIdentityResponse><GetCallerIdentityResult><Arn>arn:aws:iam::123456789012:user/test</Arn><UserId>AIDACKCEVSQ6C2EXAMPLE</UserId><Account>123456789012</Account></GetCallerIdentityResult><ResponseMetadata><RequestId>3</RequestId></ResponseMetadata></GetCallerIdentityResponse>`
+	)
+
+	telemetry := newAPICallTelemetry()
+	httpClient := &stubHTTPClient{
+		responses: []stubHTTPResponse{
+			{http.StatusBadRequest, throttlingResponse},
+			{http.StatusBadRequest, retryableResponse},
+			{http.StatusOK, successResponse},
+			{http.StatusForbidden, `<ErrorResponse><Error><Type>Sender</Type><Code>AccessDenied</Code><Message>denied</Message></Error><RequestId>4</RequestId></ErrorResponse>`},
+		},
+	}
+	client := sts.New(sts.Options{
+		APIOptions:  []func(*smithymiddleware.Stack) error{telemetry.apiOption},
+		Credentials: credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		HTTPClient:  httpClient,
+		Region:      "us-west-2", //lintignore:AWSAT003
+		Retryer: AddIsErrorRetryables(retry.NewStandard(func(o *retry.StandardOptions) {
+			o.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) {
+				return 0, nil
+			})
+		}), retry.IsErrorRetryableFunc(func(err error) aws.Ternary {
+			if errs.Contains(err, "testing") {
+				return aws.TrueTernary
+			}
+			return aws.UnknownTernary
+		})),
+	})
+
+	ctx := NewResourceContext(context.Background(), "sts", "Test", "aws_test")
+	SetTerraformOperation(ctx, "Create")
+
+	if _, err := client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := client.GetCallerIdentity(context.Background(), &sts.GetCallerIdentityInput{}); err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	b, err := json.Marshal(telemetry.stats)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got map[string]map[string]map[string]struct {
+		Calls     int `json:"calls"`
+		Errors    int `json:"errors"`
+		Attempts  int `json:"attempts"`
+		Retries   int `json:"retries"`
+		Throttles int `json:"throttles"`
+		LatencyMS struct {
+			Counts []int `json:"counts"`
+		} `json:"latency_ms"`
+	}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	type summary struct {
+		Calls, Errors, Attempts, Retries, Throttles, Observations int
+	}
+	summaries := make(map[string]summary)
+	for resourceType, v := range got {
+		for terraformOperation, v := range v {
+			for apiOperation, v := range v {
+				var observations int
+				for _, n := range v.LatencyMS.Counts {
+					observations += n
+				}
+				summaries[resourceType+"/"+terraformOperation+"/"+apiOperation] = summary{v.Calls, v.Errors, v.Attempts, v.Retries, v.Throttles, observations}
+			}
+		}
+	}
+
+	expected := map[string]summary{
+		"aws_test/Create/STS.GetCallerIdentity": {Calls: 1, Attempts: 3, Retries: 2, Throttles: 1, Observations: 1},
+		"provider//STS.GetCallerIdentity":       {Calls: 1, Errors: 1, Attempts: 1, Observations: 1},
+	}
+	if diff := cmp.Diff(summaries, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestAPICallTelemetryWrite(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "telemetry.json")
+
+	telemetry := newAPICallTelemetry()
+	telemetry.record("aws_test", "Read", "STS.GetCallerIdentity", apiCallResult{attempts: 2, retries: 1, latency: 20 * time.Millisecond})
+	if err := telemetry.write(path); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	telemetry.record("aws_test", "Read", "STS.GetCallerIdentity", apiCallResult{attempts: 1, latency: time.Second})
+	if err := telemetry.write(path); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Only this process's file is written.
+	if _, err := os.Stat(path); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("configured file written: %v", err)
+	}
+
+	b, err := os.ReadFile(apiCallTelemetryProcessFile(path, os.Getppid(), os.Getpid()))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got map[string]map[string]map[string]*apiCallStats
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	v := got["aws_test"]["Read"]["STS.GetCallerIdentity"]
+	if v == nil {
+		t.Fatal("missing statistics")
+	}
+	if got, want := *v, (apiCallStats{
+		Calls:    2,
+		Attempts: 3,
+		Retries:  1,
+		LatencyMS: apiCallLatencyHistogram{
+			Total:  1020,
+			Max:    1000,
+			Bounds: apiCallLatencyBucketsMS,
+			Counts: []int{0, 1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0},
+		},
+	}); !cmp.Equal(got, want) {
+		t.Errorf("unexpected diff (+wanted, -got): %s", cmp.Diff(got, want))
+	}
+}
+
+func TestAPICallTelemetryProcessFile(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     string
+		expected string
+	}{
+		"extension": {
+			path:     "/tmp/telemetry.json",
+			expected: "/tmp/telemetry.1234.5678.json",
+		},
+		"no extension": {
+			path:     "telemetry",
+			expected: "telemetry.1234.5678",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := apiCallTelemetryProcessFile(testCase.path, 1234, 5678), testCase.expected; got != want {
+				t.Errorf("got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestAPICallLatencyHistogram(t *testing.T) {
+	t.Parallel()
+
+	var h apiCallLatencyHistogram
+	for _, d := range []time.Duration{5 * time.Millisecond, 10 * time.Millisecond, 11 * time.Millisecond, time.Minute} {
+		h.observe(d)
+	}
+
+	if got, want := h.Counts, []int{2, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}; !cmp.Equal(got, want) {
+		t.Errorf("Counts = %v, want %v", got, want)
+	}
+	if got, want := h.Max, time.Minute.Milliseconds(); got != want {
+		t.Errorf("Max = %d, want %d", got, want)
+	}
+	if got, want := h.Total, int64(5+10+11)+time.Minute.Milliseconds(); got != want {
+		t.Errorf("Total = %d, want %d", got, want)
+	}
+}
+
+type stubHTTPResponse struct {
+	statusCode int
+	body       string
+}
+
+// stubHTTPClient returns canned responses in order.
+type stubHTTPClient struct {
+	responses []stubHTTPResponse
+}
+
+func (c *stubHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	r := c.responses[0]
+	c.responses = c.responses[1:]
+
+	return &http.Response{
+		StatusCode: r.statusCode,
+		Header:     http.Header{"Content-Type": []string{"text/xml"}},
+		Body:       io.NopCloser(strings.NewReader(r.body)),
+		Request:    req,
+	}, nil
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package discovery finds the existing resources in an AWS account and writes Terraform import blocks for them.
+//
+// Resources are found by running the service packages' sweepers in dry-run mode, so only resource types with sweepers are discovered.
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+var registerOnce sync.Once
+
+// Discover runs the sweepers in dry-run mode in each of the specified Regions and returns the importable resources that they find.
+func Discover(ctx context.Context, runner *sweep.Runner, regions []string) ([]sweep.DiscoveredResource, error) {
+	registerOnce.Do(func() {
+		sweep.ServicePackages = servicePackages(ctx)
+
+		registerSweepers()
+	})
+
+	return runner.Discover(regions)
+}
+
+// WriteImports writes a Terraform import block for each resource.
+// If resources are in more than one Region, a provider configuration is written for each Region
+// and the import blocks refer to the Region's provider configuration.
+func WriteImports(w io.Writer, resources []sweep.DiscoveredResource) error {
+	var regions []string
+	for _, v := range resources {
+		if !slices.Contains(regions, v.Region) {
+			regions = append(regions, v.Region)
+		}
+	}
+	multiRegion := len(regions) > 1
+
+	var sb strings.Builder
+
+	if multiRegion {
+		for _, region := range regions {
+			fmt.Fprintf(&sb, "provider \"aws\" {\n  alias  = %s\n  region = %s\n}\n\n", quote(providerAlias(region)), quote(region))
+		}
+	}
+
+	addresses := make(map[string]struct{})
+	for _, v := range resources {
+		name := resourceName(v)
+		if multiRegion {
+			name += "_" + providerAlias(v.Region)
+		}
+
+		// Resource addresses must be unique.
+		address := v.Type + "." + name
+		for i := 2; ; i++ {
+			if _, ok := addresses[address]; !ok {
+				break
+			}
+			address = fmt.Sprintf("%s.%s_%d", v.Type, name, i)
+		}
+		addresses[address] = struct{}{}
+
+		sb.WriteString("import {\n")
+		if multiRegion {
+			fmt.Fprintf(&sb, "  provider = aws.%s\n", providerAlias(v.Region))
+		}
+		fmt.Fprintf(&sb, "  to       = %s\n", address)
+		fmt.Fprintf(&sb, "  id       = %s\n", quote(v.ImportID))
+		sb.WriteString("}\n\n")
+	}
+
+	_, err := io.WriteString(w, strings.TrimSuffix(sb.String(), "\n"))
+
+	return err
+}
+
+var invalidNameCharsRegexp = regexache.MustCompile(`[^a-z0-9_]+`)
+
+// resourceName returns a Terraform resource name derived from the resource's import ID.
+// The last element of an ARN or path-like ID is used.
+func resourceName(v sweep.DiscoveredResource) string {
+	id := v.ImportID
+	if i := strings.LastIndexAny(id, ":/"); i >= 0 && i < len(id)-1 {
+		id = id[i+1:]
+	}
+
+	name := strings.Trim(invalidNameCharsRegexp.ReplaceAllString(strings.ToLower(id), "_"), "_")
+
+	switch {
+	case name == "":
+		return "this"
+	case name[0] >= '0' && name[0] <= '9':
+		// Names must start with a letter or underscore.
+		return "r_" + name
+	default:
+		return name
+	}
+}
+
+// providerAlias returns the provider configuration alias for the Region.
+func providerAlias(region string) string {
+	return strings.ReplaceAll(region, "-", "_")
+}
+
+// quote returns a Terraform quoted string literal.
+func quote(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`, "${", "$${", "%{", "%%{").Replace(s)
+
+	return `"` + s + `"`
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package discovery_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/discovery"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func TestWriteImports(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resources []sweep.DiscoveredResource
+		expected  string
+	}{
+		"none": {
+			expected: "",
+		},
+		"single Region": {
+			resources: []sweep.DiscoveredResource{
+				{Region: "us-west-2", Type: "aws_sqs_queue", ImportID: "https://sqs.us-west-2.amazonaws.com/123456789012/queue-1"}, //lintignore:AWSAT003
+				{Region: "us-west-2", Type: "aws_sqs_queue", ImportID: "https://sqs.us-west-2.amazonaws.com/123456789012/Queue.1"}, //lintignore:AWSAT003
+				{Region: "us-west-2", Type: "aws_prometheus_scraper", ImportID: "s-0123"},                                          //lintignore:AWSAT003
+				{Region: "us-west-2", Type: "aws_kms_key", ImportID: "1234abcd-12ab-34cd-56ef-1234567890ab"},                       //lintignore:AWSAT003
+				{Region: "us-west-2", Type: "aws_ssm_parameter", ImportID: "${x}"},                                                 //lintignore:AWSAT003
+			},
+			expected: `import {
+  to       = aws_sqs_queue.queue_1
+  id       = "https://sqs.us-west-2.amazonaws.com/123456789012/queue-1"
+}
+
+import {
+  to       = aws_sqs_queue.queue_1_2
+  id       = "https://sqs.us-west-2.amazonaws.com/123456789012/Queue.1"
+}
+
+import {
+  to       = aws_prometheus_scraper.s_0123
+  id       = "s-0123"
+}
+
+import {
+  to       = aws_kms_key.r_1234abcd_12ab_34cd_56ef_1234567890ab
+  id       = "1234abcd-12ab-34cd-56ef-1234567890ab"
+}
+
+import {
+  to       = aws_ssm_parameter.x
+  id       = "$${x}"
+}
+`, //lintignore:AWSAT003
+		},
+		"multiple Regions": {
+			resources: []sweep.DiscoveredResource{
+				{Region: "us-west-2", Type: "aws_prometheus_scraper", ImportID: "s-0123"}, //lintignore:AWSAT003
+				{Region: "us-east-1", Type: "aws_prometheus_scraper", ImportID: "s-0123"}, //lintignore:AWSAT003
+			},
+			expected: `provider "aws" {
+  alias  = "us_west_2"
+  region = "us-west-2"
+}
+
+provider "aws" {
+  alias  = "us_east_1"
+  region = "us-east-1"
+}
+
+import {
+  provider = aws.us_west_2
+  to       = aws_prometheus_scraper.s_0123_us_west_2
+  id       = "s-0123"
+}
+
+import {
+  provider = aws.us_east_1
+  to       = aws_prometheus_scraper.s_0123_us_east_1
+  id       = "s-0123"
+}
+`, //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var sb strings.Builder
+			if err := discovery.WriteImports(&sb, testCase.resources); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(sb.String(), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+// TestDiscoverOffline discovers resources from the AWS API responses recorded in testdata/cassettes.
+func TestDiscoverOffline(t *testing.T) { //nolint:paralleltest // Sweepers are process-wide.
+	t.Setenv(conns.OfflineCassetteDirEnvVar, "testdata/cassettes")
+	// A custom CA bundle can't be added to the offline mode HTTP client.
+	t.Setenv("AWS_CA_BUNDLE", "")
+
+	ctx := context.Background()
+	runner := &sweep.Runner{
+		Filter: "aws_prometheus_scraper",
+	}
+
+	resources, err := discovery.Discover(ctx, runner, []string{"us-west-2"}) //lintignore:AWSAT003
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []sweep.DiscoveredResource{
+		{Region: "us-west-2", Type: "aws_prometheus_scraper", ImportID: "s-0123abcd-4567-89ef-0123-456789abcdef"}, //lintignore:AWSAT003
+		{Region: "us-west-2", Type: "aws_prometheus_scraper", ImportID: "s-89abcdef-0123-4567-89ab-cdef01234567"}, //lintignore:AWSAT003
+	}
+	if diff := cmp.Diff(resources, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
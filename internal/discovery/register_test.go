@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package discovery_test
+
+import (
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// TestSweeperRegistrationImports checks that discovery registers the sweepers of the same service packages as the sweep tests.
+// Run `go generate` in this directory if it fails.
+func TestSweeperRegistrationImports(t *testing.T) {
+	t.Parallel()
+
+	imports := func(path string) []string {
+		t.Helper()
+
+		f, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.ImportsOnly)
+		if err != nil {
+			t.Fatalf("parsing %s: %s", path, err)
+		}
+
+		var paths []string
+		for _, v := range f.Imports {
+			path, err := strconv.Unquote(v.Path.Value)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			paths = append(paths, path)
+		}
+
+		return paths
+	}
+
+	got := imports("register_gen.go")
+	want := imports(filepath.Join("..", "sweep", "register_gen_test.go"))
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("register_gen.go imports differ from the sweep tests' (-want, +got): %s", diff)
+	}
+}
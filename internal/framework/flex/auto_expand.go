@@ -50,6 +50,20 @@ func Expand(ctx context.Context, tfObject, apiObject any, optFns ...AutoFlexOpti
 	return diags
 }
 
+// ExpandField "expands" a single field of a resource's "business logic" data structure
+// into a field of an AWS SDK for Go v2 API data structure, exactly as Expand does when
+// walking the containing data structures.
+// `tfField` and `apiField` are pointers to the fields.
+// It is used by AutoFlex generated code for fields that have no static conversion.
+func ExpandField(ctx context.Context, tfField, apiField any, legacy bool, optFns ...AutoFlexOptionsFunc) diag.Diagnostics {
+	expander := newAutoExpander(optFns)
+	fieldOpts := fieldOpts{
+		legacy: legacy,
+	}
+
+	return expander.convert(ctx, path.Empty(), reflect.ValueOf(tfField).Elem(), path.Empty(), reflect.ValueOf(apiField).Elem(), fieldOpts)
+}
+
 type autoExpander struct {
 	Options AutoFlexOptions
 }
@@ -99,6 +113,15 @@ func autoExpandConvert(ctx context.Context, from, to any, flexer autoFlexer) dia
 			diags.Append(autoFlexConvertStruct(ctx, sourcePath, from, targetPath, to, flexer)...)
 			return diags
 		}
+
+		// Top-level struct to registered union conversion.
+		if typFrom, typTo := valFrom.Type(), valTo.Type(); typFrom.Kind() == reflect.Struct && typTo.Kind() == reflect.Interface {
+			if _, ok := registeredUnionMembers(typTo); ok {
+				tflog.SubsystemInfo(ctx, subsystemName, "Converting")
+				diags.Append(autoFlexConvertStruct(ctx, sourcePath, from, targetPath, to, flexer)...)
+				return diags
+			}
+		}
 	}
 
 	// Anything else.
@@ -49,6 +49,21 @@ func Flatten(ctx context.Context, apiObject, tfObject any, optFns ...AutoFlexOpt
 	return diags
 }
 
+// FlattenField "flattens" a single field of an AWS SDK for Go v2 API data structure
+// into a field of a resource's "business logic" data structure, exactly as Flatten does
+// when walking the containing data structures.
+// `apiField` and `tfField` are pointers to the fields.
+// It is used by AutoFlex generated code for fields that have no static conversion.
+func FlattenField(ctx context.Context, apiField, tfField any, legacy, omitempty bool, optFns ...AutoFlexOptionsFunc) diag.Diagnostics {
+	flattener := newAutoFlattener(optFns)
+	fieldOpts := fieldOpts{
+		legacy:    legacy,
+		omitempty: omitempty,
+	}
+
+	return flattener.convert(ctx, path.Empty(), reflect.ValueOf(apiField).Elem(), path.Empty(), reflect.ValueOf(tfField).Elem(), fieldOpts)
+}
+
 type autoFlattener struct {
 	Options AutoFlexOptions
 }
@@ -188,7 +203,7 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo)...)
+		diags.Append(flattener.interface_(ctx, sourcePath, vFrom, targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -607,7 +622,7 @@ func (flattener autoFlattener) pointer(ctx context.Context, sourcePath path.Path
 	return diags
 }
 
-func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interface_(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
@@ -651,7 +666,7 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 		//
 		// interface -> types.List(OfObject) or types.Object.
 		//
-		diags.Append(flattener.interfaceToNestedObject(ctx, vFrom, vFrom.IsNil(), tTo, vTo)...)
+		diags.Append(flattener.interfaceToNestedObject(ctx, sourcePath, vFrom, vFrom.IsNil(), targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -1200,7 +1215,7 @@ func (flattener autoFlattener) structToNestedObject(ctx context.Context, sourceP
 }
 
 // interfaceToNestedObject copies an AWS API interface value to a compatible Plugin Framework NestedObjectValue value.
-func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, isNullFrom bool, targetPath path.Path, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if isNullFrom {
@@ -1222,6 +1237,25 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 
 	toFlattener, ok := to.(Flattener)
 	if !ok {
+		if typFrom := vFrom.Elem().Type(); typFrom.Kind() == reflect.Pointer {
+			if _, ok := registeredUnionMember(typFrom.Elem()); ok {
+				diags.Append(autoFlexConvertStruct(ctx, sourcePath, vFrom.Interface(), targetPath, to, flattener)...)
+				if diags.HasError() {
+					return diags
+				}
+
+				// Set the target structure as a mapped Object.
+				val, d := tTo.ValueFromObjectPtr(ctx, to)
+				diags.Append(d...)
+				if diags.HasError() {
+					return diags
+				}
+
+				vTo.Set(reflect.ValueOf(val))
+				return diags
+			}
+		}
+
 		val, d := tTo.NullValue(ctx)
 		diags.Append(d...)
 		if diags.HasError() {
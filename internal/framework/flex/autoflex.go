@@ -102,6 +102,11 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 
 	// TODO: this only applies when Expanding
 	if valTo.Kind() == reflect.Interface {
+		if members, ok := registeredUnionMembers(valTo.Type()); ok {
+			diags.Append(expandUnion(ctx, sourcePath, valFrom, targetPath, valTo, members, flexer)...)
+			return diags
+		}
+
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
@@ -116,6 +121,12 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 		return diags
 	}
 
+	// TODO: this only applies when Flattening
+	if member, ok := registeredUnionMember(valFrom.Type()); ok {
+		diags.Append(flattenUnion(ctx, sourcePath, valFrom, targetPath, valTo, member, flexer)...)
+		return diags
+	}
+
 	typeFrom := valFrom.Type()
 	typeTo := valTo.Type()
 
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package flextest contains helpers for testing AutoFlex generated code.
+package flextest
+
+import (
+	"context"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+const (
+	// iterations is the number of random values checked by each helper.
+	iterations = 100
+	// maxDepth limits the nesting of random values.
+	maxDepth = 4
+	// seed makes random values reproducible.
+	seed = 42
+)
+
+// ExpandMatches checks that the AutoFlex generated expander `f` produces the same results as fwflex.Expand.
+// The Terraform values expanded are the zero value and values flattened by fwflex.Flatten from random AWS API values.
+func ExpandMatches[T, U any](t *testing.T, f func(context.Context, *T, *U) diag.Diagnostics, optFns ...fwflex.AutoFlexOptionsFunc) {
+	t.Helper()
+
+	ctx := context.Background()
+	rnd := newRandomizer()
+	inputs := []*T{new(T)}
+
+	for range iterations {
+		var from U
+		rnd.populate(reflect.ValueOf(&from).Elem(), 0)
+
+		to := new(T)
+		if diags := fwflex.Flatten(ctx, &from, to, optFns...); diags.HasError() {
+			continue
+		}
+		inputs = append(inputs, to)
+	}
+
+	for i, input := range inputs {
+		var want, got U
+		wantDiags := fwflex.Expand(ctx, input, &want, optFns...)
+		gotDiags := f(ctx, input, &got)
+
+		if wantDiags.HasError() != gotDiags.HasError() {
+			t.Fatalf("input %d: unexpected diagnostics\nwant: %v\ngot: %v", i, wantDiags, gotDiags)
+		}
+		if wantDiags.HasError() {
+			continue
+		}
+		if diff := cmp.Diff(want, got, exportAll()); diff != "" {
+			t.Fatalf("input %d: unexpected diff (+got, -want): %s", i, diff)
+		}
+	}
+}
+
+// FlattenMatches checks that the AutoFlex generated flattener `f` produces the same results as fwflex.Flatten.
+// The AWS API values flattened are the zero value and random values.
+func FlattenMatches[U, T any](t *testing.T, f func(context.Context, *U, *T) diag.Diagnostics, optFns ...fwflex.AutoFlexOptionsFunc) {
+	t.Helper()
+
+	ctx := context.Background()
+	rnd := newRandomizer()
+	inputs := []*U{new(U)}
+
+	for range iterations {
+		from := new(U)
+		rnd.populate(reflect.ValueOf(from).Elem(), 0)
+		inputs = append(inputs, from)
+	}
+
+	for i, input := range inputs {
+		var want, got T
+		wantDiags := fwflex.Flatten(ctx, input, &want, optFns...)
+		gotDiags := f(ctx, input, &got)
+
+		if wantDiags.HasError() != gotDiags.HasError() {
+			t.Fatalf("input %d: unexpected diagnostics\nwant: %v\ngot: %v", i, wantDiags, gotDiags)
+		}
+		if wantDiags.HasError() {
+			continue
+		}
+		if diff := cmp.Diff(want, got, exportAll()); diff != "" {
+			t.Fatalf("input %d: unexpected diff (+got, -want): %s", i, diff)
+		}
+	}
+}
+
+func exportAll() cmp.Option {
+	return cmp.Exporter(func(reflect.Type) bool { return true })
+}
+
+// randomizer populates values with reproducible random data.
+type randomizer struct {
+	rand *rand.Rand
+}
+
+func newRandomizer() *randomizer {
+	return &randomizer{
+		rand: rand.New(rand.NewPCG(seed, seed)), //nolint:gosec // Test data.
+	}
+}
+
+func (r *randomizer) populate(v reflect.Value, depth int) {
+	if !v.CanSet() {
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(r.rand.IntN(2) == 1)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(r.rand.IntN(2001) - 1000))
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(r.rand.IntN(1001)))
+
+	case reflect.Float32, reflect.Float64:
+		// Values that are exactly representable as float32.
+		v.SetFloat(float64(r.rand.IntN(2001)-1000) / 4)
+
+	case reflect.String:
+		v.SetString(r.string())
+
+	case reflect.Pointer:
+		if depth >= maxDepth || r.rand.IntN(4) == 0 {
+			return
+		}
+		p := reflect.New(v.Type().Elem())
+		r.populate(p.Elem(), depth+1)
+		v.Set(p)
+
+	case reflect.Slice:
+		if depth >= maxDepth || r.rand.IntN(4) == 0 {
+			return
+		}
+		n := r.rand.IntN(3)
+		s := reflect.MakeSlice(v.Type(), n, n)
+		for i := range n {
+			r.populate(s.Index(i), depth+1)
+		}
+		v.Set(s)
+
+	case reflect.Map:
+		if depth >= maxDepth || r.rand.IntN(4) == 0 || v.Type().Key().Kind() != reflect.String {
+			return
+		}
+		m := reflect.MakeMap(v.Type())
+		for range r.rand.IntN(3) {
+			key := reflect.New(v.Type().Key()).Elem()
+			key.SetString(r.string())
+			elem := reflect.New(v.Type().Elem()).Elem()
+			r.populate(elem, depth+1)
+			m.SetMapIndex(key, elem)
+		}
+		v.Set(m)
+
+	case reflect.Struct:
+		if v.Type() == reflect.TypeFor[time.Time]() {
+			v.Set(reflect.ValueOf(time.Unix(int64(r.rand.IntN(2_000_000_000)), 0).UTC()))
+			return
+		}
+		for i := range v.NumField() {
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+			r.populate(v.Field(i), depth)
+		}
+
+	case reflect.Interface:
+		// Unions and documents are left unset.
+	}
+}
+
+func (r *randomizer) string() string {
+	const letters = "abcdefghijklmnopqrstuvwxyz"
+
+	n := r.rand.IntN(6)
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = letters[r.rand.IntN(len(letters))]
+	}
+
+	return string(b)
+}
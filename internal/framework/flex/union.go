@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	unionMemberInfix          = "Member"
+	unionMemberValueFieldName = "Value"
+)
+
+// unionMember describes a single member of an AWS SDK for Go v2 union type.
+type unionMember struct {
+	// name is the member name, e.g. "CognitoUserPoolConfiguration" for "ConfigurationMemberCognitoUserPoolConfiguration".
+	name string
+	// typ is the member's concrete type, e.g. "*ConfigurationMemberCognitoUserPoolConfiguration".
+	typ reflect.Type
+}
+
+var (
+	unionRegistryLock sync.RWMutex
+	// unionTypes maps a union (interface) type to its members.
+	unionTypes = make(map[reflect.Type][]unionMember)
+	// unionMemberTypes maps a member's struct type to its description.
+	unionMemberTypes = make(map[reflect.Type]unionMember)
+)
+
+// RegisterUnionType registers the members of an AWS SDK for Go v2 union type
+// (a tagged interface such as `awstypes.Configuration`) with AutoFlex.
+// Each member is a pointer to the zero value of a member type, e.g. `&awstypes.ConfigurationMemberCognitoUserPoolConfiguration{}`.
+//
+// A registered union is expanded from, and flattened to, a Terraform model with one nested object (block) per member.
+// Model fields are matched to member names (the member type name without the "<union>Member" prefix) exactly as struct fields are matched.
+// Exactly one member block may be set when expanding.
+//
+// Models implementing flex.Expander, flex.TypedExpander or flex.Flattener take precedence over registered unions.
+//
+// Service packages register their unions using the generator in internal/generate/unions rather than calling this directly.
+func RegisterUnionType[T any](members ...T) {
+	typUnion := reflect.TypeFor[T]()
+	if typUnion.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("flex.RegisterUnionType: %s is not an interface type", fullTypeName(typUnion)))
+	}
+
+	unionRegistryLock.Lock()
+	defer unionRegistryLock.Unlock()
+
+	var ms []unionMember
+	for _, member := range members {
+		typMember := reflect.TypeOf(member)
+		if typMember == nil || typMember.Kind() != reflect.Pointer || typMember.Elem().Kind() != reflect.Struct {
+			panic(fmt.Sprintf("flex.RegisterUnionType: member %s of %s is not a struct pointer", fullTypeName(typMember), fullTypeName(typUnion)))
+		}
+		typStruct := typMember.Elem()
+
+		name, ok := strings.CutPrefix(typStruct.Name(), typUnion.Name()+unionMemberInfix)
+		if !ok || name == "" {
+			panic(fmt.Sprintf("flex.RegisterUnionType: %s is not a member of %s", fullTypeName(typStruct), fullTypeName(typUnion)))
+		}
+		if _, ok := typStruct.FieldByName(unionMemberValueFieldName); !ok {
+			panic(fmt.Sprintf("flex.RegisterUnionType: %s has no %s field", fullTypeName(typStruct), unionMemberValueFieldName))
+		}
+
+		m := unionMember{
+			name: name,
+			typ:  typMember,
+		}
+		ms = append(ms, m)
+		unionMemberTypes[typStruct] = m
+	}
+
+	unionTypes[typUnion] = ms
+}
+
+// registeredUnionMembers returns the registered members of the specified union type.
+func registeredUnionMembers(typ reflect.Type) ([]unionMember, bool) {
+	unionRegistryLock.RLock()
+	defer unionRegistryLock.RUnlock()
+
+	ms, ok := unionTypes[typ]
+	return ms, ok
+}
+
+// registeredUnionMember returns the registered union member with the specified struct type.
+func registeredUnionMember(typ reflect.Type) (unionMember, bool) {
+	unionRegistryLock.RLock()
+	defer unionRegistryLock.RUnlock()
+
+	m, ok := unionMemberTypes[typ]
+	return m, ok
+}
+
+// expandUnion expands the Terraform model `valFrom` into the union (interface) value `valTo`.
+// The single model field that is set is expanded into the `Value` field of the corresponding member.
+func expandUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, members []unionMember, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Target is a registered union type")
+
+	typeFrom := valFrom.Type()
+
+	var (
+		setMember    unionMember
+		setField     reflect.StructField
+		setFieldOpts fieldOpts
+		setNames     []string
+		names        []string
+	)
+	for _, member := range members {
+		fromField, ok := findFieldFuzzy(ctx, member.name, member.typ.Elem(), typeFrom, flexer)
+		if !ok {
+			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
+				logAttrKeyTargetFieldname: member.name,
+			})
+			continue
+		}
+		fromNameOverride, fromOpts := autoflexTags(fromField)
+		if fromNameOverride == "-" {
+			tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
+				logAttrKeySourceFieldname: fromField.Name,
+			})
+			continue
+		}
+		names = append(names, fromField.Name)
+
+		if v, ok := valFrom.FieldByIndex(fromField.Index).Interface().(attr.Value); ok && (v.IsNull() || v.IsUnknown()) {
+			continue
+		}
+
+		setMember, setField = member, fromField
+		setFieldOpts = fieldOpts{
+			legacy: fromOpts.Legacy(),
+		}
+		setNames = append(setNames, fromField.Name)
+	}
+
+	if len(setNames) != 1 {
+		tflog.SubsystemError(ctx, subsystemName, "Expanding union; exactly one member must be set", map[string]any{
+			"members": names,
+			"set":     setNames,
+		})
+		diags.Append(diagExpandingUnionMemberCount(typeFrom, valTo.Type(), names, setNames))
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+		logAttrKeySourceFieldname: setField.Name,
+		logAttrKeyTargetFieldname: setMember.name,
+	})
+
+	to := reflect.New(setMember.typ.Elem())
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(setField.Name), valFrom.FieldByIndex(setField.Index), targetPath, to.Elem().FieldByName(unionMemberValueFieldName), setFieldOpts)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	valTo.Set(to)
+
+	return diags
+}
+
+// flattenUnion flattens the union member value `valFrom` into the Terraform model `valTo`.
+// The member's `Value` field is flattened into the corresponding model field and all other nested object fields are set to null.
+func flattenUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, member unionMember, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Source is a registered union member")
+
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	toField, ok := findFieldFuzzy(ctx, member.name, valFrom.Type(), valTo.Type(), flexer)
+	if !ok {
+		tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
+			logAttrKeySourceFieldname: member.name,
+		})
+		return diags
+	}
+	toNameOverride, toOpts := autoflexTags(toField)
+	if toNameOverride == "-" || toOpts.NoFlatten() {
+		tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored target field", map[string]any{
+			logAttrKeySourceFieldname: member.name,
+			logAttrKeyTargetFieldname: toField.Name,
+		})
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+		logAttrKeySourceFieldname: member.name,
+		logAttrKeyTargetFieldname: toField.Name,
+	})
+
+	opts := fieldOpts{
+		legacy:    toOpts.Legacy(),
+		omitempty: toOpts.OmitEmpty(),
+	}
+
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(unionMemberValueFieldName), valFrom.FieldByName(unionMemberValueFieldName), targetPath.AtName(toField.Name), valTo.FieldByIndex(toField.Index), opts)...)
+
+	return diags
+}
+
+func diagExpandingUnionMemberCount(sourceType, targetType reflect.Type, names, setNames []string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Union Configuration",
+		fmt.Sprintf("Exactly one of %s must be set when expanding %q to %q, got %d.", strings.Join(names, ", "), fullTypeName(sourceType), fullTypeName(targetType), len(setNames)),
+	)
+}
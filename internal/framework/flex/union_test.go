@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+type tfUnion struct {
+	Text   types.String                                         `tfsdk:"text"`
+	Nested fwtypes.ListNestedObjectValueOf[tfSingleStringField] `tfsdk:"nested"`
+}
+
+type awsUnion interface {
+	isAWSUnion()
+}
+
+type awsUnionMemberText struct {
+	Value string
+}
+
+func (*awsUnionMemberText) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionMemberNested struct {
+	Value awsSingleStringValue
+}
+
+func (*awsUnionMemberNested) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionSingle struct {
+	Field1 awsUnion
+}
+
+type awsUnionSlice struct {
+	Field1 []awsUnion
+}
+
+func init() {
+	RegisterUnionType[awsUnion](&awsUnionMemberText{}, &awsUnionMemberNested{})
+}
+
+func TestRegisterUnionType(t *testing.T) {
+	t.Parallel()
+
+	members, ok := registeredUnionMembers(reflect.TypeFor[awsUnion]())
+	if !ok {
+		t.Fatal("union type not registered")
+	}
+
+	var got []string
+	for _, member := range members {
+		got = append(got, member.name)
+	}
+	if diff := cmp.Diff(got, []string{"Text", "Nested"}); diff != "" {
+		t.Errorf("unexpected member names diff (+wanted, -got): %s", diff)
+	}
+
+	if _, ok := registeredUnionMember(reflect.TypeFor[awsUnionMemberNested]()); !ok {
+		t.Error("union member not registered")
+	}
+
+	for name, f := range map[string]func(){
+		"not an interface": func() { RegisterUnionType(awsUnionMemberText{}) },
+		"not a member":     func() { RegisterUnionType[awsInterfaceInterface](&awsInterfaceInterfaceImpl{}) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected panic")
+				}
+			}()
+			f()
+		})
+	}
+}
+
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		source        any
+		target        any
+		wantTarget    any
+		expectedDiags diag.Diagnostics
+	}{
+		"top level primitive member": {
+			source: tfUnion{
+				Text:   types.StringValue("a"),
+				Nested: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+			},
+			target:     new(awsUnion),
+			wantTarget: testFlexAWSUnionPtr(&awsUnionMemberText{Value: "a"}),
+		},
+		"single list Source and single union Target": {
+			source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Text: types.StringNull(),
+						Nested: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{Field1: types.StringValue("b")},
+						}),
+					},
+				}),
+			},
+			target: &awsUnionSingle{},
+			wantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberNested{Value: awsSingleStringValue{Field1: "b"}},
+			},
+		},
+		"null list Source and single union Target": {
+			source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+			target:     &awsUnionSingle{},
+			wantTarget: &awsUnionSingle{},
+		},
+		"list Source and union slice Target": {
+			source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Text:   types.StringValue("a"),
+						Nested: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+					{
+						Text: types.StringNull(),
+						Nested: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{Field1: types.StringValue("b")},
+						}),
+					},
+				}),
+			},
+			target: &awsUnionSlice{},
+			wantTarget: &awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberText{Value: "a"},
+					&awsUnionMemberNested{Value: awsSingleStringValue{Field1: "b"}},
+				},
+			},
+		},
+		"no member set": {
+			source: tfUnion{
+				Text:   types.StringNull(),
+				Nested: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+			},
+			target: new(awsUnion),
+			expectedDiags: diag.Diagnostics{
+				diagExpandingUnionMemberCount(reflect.TypeFor[tfUnion](), reflect.TypeFor[awsUnion](), []string{"Text", "Nested"}, nil),
+			},
+		},
+		"multiple members set": {
+			source: tfUnion{
+				Text: types.StringValue("a"),
+				Nested: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+					{Field1: types.StringValue("b")},
+				}),
+			},
+			target: new(awsUnion),
+			expectedDiags: diag.Diagnostics{
+				diagExpandingUnionMemberCount(reflect.TypeFor[tfUnion](), reflect.TypeFor[awsUnion](), []string{"Text", "Nested"}, []string{"Text", "Nested"}),
+			},
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			diags := Expand(context.Background(), testCase.source, testCase.target)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if !diags.HasError() {
+				if diff := cmp.Diff(testCase.target, testCase.wantTarget); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		source     any
+		target     any
+		wantTarget any
+	}{
+		"primitive member": {
+			source: &awsUnionSingle{
+				Field1: &awsUnionMemberText{Value: "a"},
+			},
+			target: &tfListNestedObject[tfUnion]{},
+			wantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Text:   types.StringValue("a"),
+						Nested: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+				}),
+			},
+		},
+		"nested object member": {
+			source: &awsUnionSingle{
+				Field1: &awsUnionMemberNested{Value: awsSingleStringValue{Field1: "b"}},
+			},
+			target: &tfListNestedObject[tfUnion]{},
+			wantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Text: types.StringNull(),
+						Nested: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{Field1: types.StringValue("b")},
+						}),
+					},
+				}),
+			},
+		},
+		"nil union": {
+			source: &awsUnionSingle{},
+			target: &tfListNestedObject[tfUnion]{},
+			wantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+		},
+		"union slice": {
+			source: &awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberText{Value: "a"},
+					&awsUnionMemberNested{Value: awsSingleStringValue{Field1: "b"}},
+				},
+			},
+			target: &tfListNestedObject[tfUnion]{},
+			wantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Text:   types.StringValue("a"),
+						Nested: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+					{
+						Text: types.StringNull(),
+						Nested: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{Field1: types.StringValue("b")},
+						}),
+					},
+				}),
+			},
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			diags := Flatten(context.Background(), testCase.source, testCase.target)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(testCase.target, testCase.wantTarget); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func testFlexAWSUnionPtr(v awsUnion) *awsUnion { // nosemgrep:ci.aws-in-func-name
+	return &v
+}
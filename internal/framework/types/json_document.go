@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+// JSONNormalizers is implemented by the types that define how a JSONDocument is normalized.
+// Values of such types are never used; only the zero value's normalizers are called.
+type JSONNormalizers interface {
+	Normalizers() []tfjson.Normalizer
+}
+
+// DefaultJSONNormalizers normalizes numbers and removes empty object members.
+type DefaultJSONNormalizers struct{}
+
+func (DefaultJSONNormalizers) Normalizers() []tfjson.Normalizer {
+	return []tfjson.Normalizer{tfjson.NormalizeNumbers, tfjson.StripEmpty}
+}
+
+// NormalizedJSONDocument is a JSON document that is semantically equal to another once numbers are normalized and empty object members are removed.
+type NormalizedJSONDocument = JSONDocument[DefaultJSONNormalizers]
+
+var (
+	NormalizedJSONDocumentType = JSONDocumentType[DefaultJSONNormalizers]()
+)
+
+var (
+	_ basetypes.StringTypable = (*jsonDocumentType[DefaultJSONNormalizers])(nil)
+)
+
+type jsonDocumentType[T JSONNormalizers] struct {
+	basetypes.StringType
+}
+
+// JSONDocumentType returns the type of JSON documents that are semantically equal when their normalized forms are identical.
+func JSONDocumentType[T JSONNormalizers]() basetypes.StringTypable {
+	return jsonDocumentType[T]{}
+}
+
+func (t jsonDocumentType[T]) Equal(o attr.Type) bool {
+	other, ok := o.(jsonDocumentType[T])
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (jsonDocumentType[T]) String() string {
+	var zero T
+	return fmt.Sprintf("JSONDocumentType[%T]", zero)
+}
+
+func (t jsonDocumentType[T]) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return JSONDocumentNull[T](), diags
+	}
+	if in.IsUnknown() {
+		return JSONDocumentUnknown[T](), diags
+	}
+
+	return JSONDocument[T]{StringValue: in}, diags
+}
+
+func (t jsonDocumentType[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (jsonDocumentType[T]) ValueType(context.Context) attr.Value {
+	return JSONDocument[T]{}
+}
+
+var (
+	_ basetypes.StringValuable                   = (*JSONDocument[DefaultJSONNormalizers])(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*JSONDocument[DefaultJSONNormalizers])(nil)
+	_ xattr.ValidateableAttribute                = (*JSONDocument[DefaultJSONNormalizers])(nil)
+)
+
+func JSONDocumentNull[T JSONNormalizers]() JSONDocument[T] {
+	return JSONDocument[T]{StringValue: basetypes.NewStringNull()}
+}
+
+func JSONDocumentUnknown[T JSONNormalizers]() JSONDocument[T] {
+	return JSONDocument[T]{StringValue: basetypes.NewStringUnknown()}
+}
+
+func JSONDocumentValue[T JSONNormalizers](value string) JSONDocument[T] {
+	return JSONDocument[T]{StringValue: basetypes.NewStringValue(value)}
+}
+
+// JSONDocument is a JSON document string.
+// Documents whose forms normalized by T's normalizers are identical are semantically equal, so AWS reformatting, reordering or adding defaults to a document doesn't produce a difference.
+type JSONDocument[T JSONNormalizers] struct {
+	basetypes.StringValue
+}
+
+func (v JSONDocument[T]) Equal(o attr.Value) bool {
+	other, ok := o.(JSONDocument[T])
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (JSONDocument[T]) Type(context.Context) attr.Type {
+	return JSONDocumentType[T]()
+}
+
+func (v JSONDocument[T]) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JSONDocument[T])
+
+	if !ok {
+		return false, diags
+	}
+
+	var zero T
+	return tfjson.EquivalentStrings(v.ValueString(), newValue.ValueString(), zero.Normalizers()...), diags
+}
+
+func (v JSONDocument[T]) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(v.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON Document Value",
+			"The provided value is not valid JSON string format (RFC 7159).\n\n"+
+				"Path: "+req.Path.String()+"\n"+
+				"Value: "+v.ValueString(),
+		)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+func TestJSONDocumentValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         fwtypes.NormalizedJSONDocument
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: fwtypes.JSONDocumentUnknown[fwtypes.DefaultJSONNormalizers](),
+		},
+		"null": {
+			val: fwtypes.JSONDocumentNull[fwtypes.DefaultJSONNormalizers](),
+		},
+		"valid": {
+			val: fwtypes.JSONDocumentValue[fwtypes.DefaultJSONNormalizers](`{"Key1": "Value", "Key2": [1, 2, 3]}`),
+		},
+		"invalid": {
+			val:         fwtypes.JSONDocumentValue[fwtypes.DefaultJSONNormalizers]("not ok"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			req := xattr.ValidateAttributeRequest{}
+			resp := xattr.ValidateAttributeResponse{}
+
+			test.val.ValidateAttribute(ctx, req, &resp)
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("resp.Diagnostics.HasError() = %t, want = %t", resp.Diagnostics.HasError(), test.expectError)
+			}
+		})
+	}
+}
+
+func TestJSONDocumentStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val1, val2 string
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"identical": {
+			val1:   `{"a":1}`,
+			val2:   `{"a":1}`,
+			equals: true,
+		},
+		"reformatted": {
+			val1: `{"a": 1, "b": {"c": [1, 2]}}`,
+			val2: `{
+  "b": {"c": [1, 2.0]},
+  "a": 1.0
+}`,
+			equals: true,
+		},
+		"empty members": {
+			val1:   `{"a": 1}`,
+			val2:   `{"a": 1, "b": null, "c": [], "d": {}}`,
+			equals: true,
+		},
+		"not equals": {
+			val1: `{"a": 1}`,
+			val2: `{"a": 2}`,
+		},
+		"reordered array": {
+			val1: `{"a": [1, 2]}`,
+			val2: `{"a": [2, 1]}`,
+		},
+		"invalid": {
+			val1: `{"a": 1}`,
+			val2: `not ok`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, _ := fwtypes.JSONDocumentValue[fwtypes.DefaultJSONNormalizers](test.val1).StringSemanticEquals(ctx, fwtypes.JSONDocumentValue[fwtypes.DefaultJSONNormalizers](test.val2))
+
+			if got, expected := equals, test.equals; got != expected {
+				t.Errorf("StringSemanticEquals(%q, %q) = %t, want %t", test.val1, test.val2, got, expected)
+			}
+		})
+	}
+}
+
+func TestJSONDocumentStringSemanticEqualsCustomNormalizers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	val1 := fwtypes.JSONDocumentValue[testJSONNormalizers](`{"environment": [{"name": "A"}, {"name": "B"}]}`)
+	val2 := fwtypes.JSONDocumentValue[testJSONNormalizers](`{"environment": [{"name": "B"}, {"name": "A"}], "essential": true}`)
+
+	equals, _ := val1.StringSemanticEquals(ctx, val2)
+
+	if !equals {
+		t.Errorf("StringSemanticEquals = %t, want true", equals)
+	}
+}
+
+type testJSONNormalizers struct{}
+
+func (testJSONNormalizers) Normalizers() []tfjson.Normalizer {
+	return []tfjson.Normalizer{
+		tfjson.StripDefaults(map[string]any{"essential": true}),
+		tfjson.SortArraysByField("name"),
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// UnionMemberBlocks returns the nested blocks for the members of an AWS SDK for Go v2 union type that is registered with AutoFlex.
+// Each member block is a list of at most one element and exactly one member may be configured.
+// attributeNames are the names of any of the union's members that are declared as attributes, see UnionMemberAttributes.
+func UnionMemberBlocks(blocks map[string]schema.ListNestedBlock, attributeNames ...string) map[string]schema.Block {
+	expressions := unionMemberExpressions(slices.Concat(slices.Collect(maps.Keys(blocks)), attributeNames))
+
+	members := make(map[string]schema.Block, len(blocks))
+	for name, block := range blocks {
+		block.Validators = append(slices.Clone(block.Validators),
+			listvalidator.SizeAtMost(1),
+			listvalidator.ExactlyOneOf(expressions...),
+		)
+		members[name] = block
+	}
+
+	return members
+}
+
+// UnionMemberAttributes returns the attributes for the scalar members of an AWS SDK for Go v2 union type that is registered with AutoFlex.
+// Exactly one member may be configured.
+// blockNames are the names of any of the union's members that are declared as blocks, see UnionMemberBlocks.
+func UnionMemberAttributes(attributes map[string]schema.StringAttribute, blockNames ...string) map[string]schema.Attribute {
+	expressions := unionMemberExpressions(slices.Concat(slices.Collect(maps.Keys(attributes)), blockNames))
+
+	members := make(map[string]schema.Attribute, len(attributes))
+	for name, attribute := range attributes {
+		attribute.Optional = true
+		attribute.Validators = append(slices.Clone(attribute.Validators),
+			stringvalidator.ExactlyOneOf(expressions...),
+		)
+		members[name] = attribute
+	}
+
+	return members
+}
+
+func unionMemberExpressions(names []string) []path.Expression {
+	slices.Sort(names)
+
+	return tfslices.ApplyToAll(names, func(v string) path.Expression {
+		return path.MatchRelative().AtParent().AtName(v)
+	})
+}
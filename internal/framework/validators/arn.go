@@ -5,10 +5,12 @@ package validators
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
 type arnValidator struct{}
@@ -39,3 +41,64 @@ func (validator arnValidator) ValidateString(ctx context.Context, request valida
 func ARN() validator.String {
 	return arnValidator{}
 }
+
+type arnOfValidator struct {
+	pattern itypes.ARNPattern
+}
+
+func (validator arnOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("An Amazon Resource Name of a %s resource", validator.pattern)
+}
+
+func (validator arnOfValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+func (validator arnOfValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	v, err := arn.Parse(request.ConfigValue.ValueString())
+	if err != nil {
+		response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			"value must be a valid ARN",
+		))
+		return
+	}
+
+	if !itypes.IsAWSPartition(v.Partition) {
+		response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			fmt.Sprintf("value must be an ARN in a valid partition, got: %s", v.Partition),
+		))
+		return
+	}
+
+	if !validator.pattern.Match(v) {
+		response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			fmt.Sprintf("value must be the ARN of a %s resource, got: %s", validator.pattern, request.ConfigValue.ValueString()),
+		))
+		return
+	}
+}
+
+// ARNOf returns a validator which ensures that any configured value is the ARN of a resource of the specified service,
+// e.g. `ARNOf("sqs")`, whose resource part matches one of the specified patterns, e.g. `ARNOf("iam", "role/*")`.
+// See itypes.ARNOf.
+func ARNOf(service string, resources ...string) validator.String {
+	return ARNOfPattern(itypes.ARNOf(service, resources...))
+}
+
+// ARNOfPattern returns a validator which ensures that any configured value is an ARN matching the specified pattern,
+// e.g. `ARNOfPattern(itypes.ARNOf("iam", "role/*").InPartitions("aws-us-gov"))`.
+func ARNOfPattern(pattern itypes.ARNPattern) validator.String {
+	return arnOfValidator{
+		pattern: pattern,
+	}
+}
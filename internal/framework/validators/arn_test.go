@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
 func TestARNValidator(t *testing.T) {
@@ -59,3 +60,107 @@ func TestARNValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestARNOfValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid arn": {
+			val: types.StringValue("arn:aws:iam::123456789012:role/service-role/example"), //lintignore:AWSAT005
+		},
+		"invalid_arn": {
+			val:         types.StringValue("arn"),
+			expectError: true,
+		},
+		"invalid partition": {
+			val:         types.StringValue("arn:amazon:iam::123456789012:role/example"), //lintignore:AWSAT005
+			expectError: true,
+		},
+		"wrong service": {
+			val:         types.StringValue("arn:aws:lambda:us-west-2:123456789012:function:example"), //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"wrong resource type": {
+			val:         types.StringValue("arn:aws:iam::123456789012:user/example"), //lintignore:AWSAT005
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			fwvalidators.ARNOf("iam", "role/*").ValidateString(context.Background(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestARNOfPatternValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"valid arn": {
+			val: types.StringValue("arn:aws-cn:iam::123456789012:role/example"), //lintignore:AWSAT005
+		},
+		"wrong partition": {
+			val:         types.StringValue("arn:aws:iam::123456789012:role/example"), //lintignore:AWSAT005
+			expectError: true,
+		},
+		"wrong resource type": {
+			val:         types.StringValue("arn:aws-cn:iam::123456789012:user/example"), //lintignore:AWSAT005
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			fwvalidators.ARNOfPattern(itypes.ARNOf("iam", "role/*").InPartitions("aws-cn")).ValidateString(context.Background(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
@@ -5,12 +5,19 @@ package validators
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
+var (
+	// e.g. s3://bucket/path/to/key
+	s3URIRegex = regexache.MustCompile(`^s3://([a-z0-9][\.\-a-z0-9]{1,61}[a-z0-9])(/.*)?$`)
+)
+
 // s3URIValidator validates that a string Attribute's value is a valid S3 URI.
 type s3URIValidator struct{}
 
@@ -27,7 +34,7 @@ func (validator s3URIValidator) ValidateString(ctx context.Context, request vali
 		return
 	}
 
-	if !regexache.MustCompile(`^s3://[a-z0-9][\.\-a-z0-9]{1,61}[a-z0-9](/.*)?$`).MatchString(request.ConfigValue.ValueString()) {
+	if _, _, err := ParseS3URI(request.ConfigValue.ValueString()); err != nil {
 		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
 			request.Path,
 			validator.Description(ctx),
@@ -46,3 +53,14 @@ func (validator s3URIValidator) ValidateString(ctx context.Context, request vali
 func S3URI() validator.String {
 	return s3URIValidator{}
 }
+
+// ParseS3URI returns the bucket name and object key of a valid S3 URI (s3://bucket[/key]).
+// The key is empty if the URI has no key.
+func ParseS3URI(s string) (string, string, error) {
+	m := s3URIRegex.FindStringSubmatch(s)
+	if m == nil {
+		return "", "", fmt.Errorf("invalid S3 URI: %s", s)
+	}
+
+	return m[1], strings.TrimPrefix(m[2], "/"), nil
+}
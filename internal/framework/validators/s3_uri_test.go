@@ -74,3 +74,55 @@ func TestS3URIValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestParseS3URI(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		uri            string
+		expectedBucket string
+		expectedKey    string
+		expectError    bool
+	}
+	tests := map[string]testCase{
+		"bucket": {
+			uri:            "s3://bucket",
+			expectedBucket: "bucket",
+		},
+		"bucket and key": {
+			uri:            "s3://bucket/path/to/key",
+			expectedBucket: "bucket",
+			expectedKey:    "path/to/key",
+		},
+		"trailing slash": {
+			uri:            "s3://bucket/",
+			expectedBucket: "bucket",
+		},
+		"no scheme": {
+			uri:         "bucket/key",
+			expectError: true,
+		},
+		"invalid bucket": {
+			uri:         "s3://Bucket/key",
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			bucket, key, err := fwvalidators.ParseS3URI(test.uri)
+
+			if got, want := err != nil, test.expectError; got != want {
+				t.Fatalf("ParseS3URI(%q) err %t, want %t", test.uri, got, want)
+			}
+			if got, want := bucket, test.expectedBucket; got != want {
+				t.Errorf("bucket = %q, want %q", got, want)
+			}
+			if got, want := key, test.expectedKey; got != want {
+				t.Errorf("key = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = arnFilterFunction{}
+
+func NewARNFilterFunction() function.Function {
+	return &arnFilterFunction{}
+}
+
+type arnFilterFunction struct{}
+
+func (f arnFilterFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_filter"
+}
+
+func (f arnFilterFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "arn_filter Function",
+		MarkdownDescription: "Returns the ARNs in a list that match an IAM-style ARN pattern. " +
+			"Elements that are not valid ARNs never match",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "ARN pattern, which may contain `*` and `?` wildcards",
+			},
+			function.ListParameter{
+				Name:                "list",
+				ElementType:         types.StringType,
+				MarkdownDescription: "ARNs (Amazon Resource Names) to filter",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f arnFilterFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pattern string
+	var list []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern, &list))
+	if resp.Error != nil {
+		return
+	}
+
+	p, err := parseARNPattern(pattern)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result := make([]string, 0, len(list))
+	for _, v := range list {
+		a, err := arn.Parse(v)
+		if err != nil {
+			continue
+		}
+
+		if p.matches(a) {
+			result = append(result, v)
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
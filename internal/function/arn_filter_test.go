@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestARNFilterFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNFilterFunctionConfig("arn:aws:iam::*:role/*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "2"),
+					resource.TestCheckOutput("first", "arn:aws:iam::444455556666:role/example"),
+					resource.TestCheckOutput("second", "arn:aws:iam::111122223333:role/with/path/example"),
+				),
+			},
+		},
+	})
+}
+
+func TestARNFilterFunction_invalidPattern(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testARNFilterFunctionConfig("invalid"),
+				ExpectError: regexache.MustCompile("arn: invalid prefix"),
+			},
+		},
+	})
+}
+
+func testARNFilterFunctionConfig(pattern string) string {
+	return fmt.Sprintf(`
+locals {
+  result = provider::aws::arn_filter(%[1]q, [
+    "arn:aws:iam::444455556666:role/example",
+    "arn:aws:iam::444455556666:user/example",
+    "invalid",
+    "arn:aws:iam::111122223333:role/with/path/example",
+  ])
+}
+
+output "count" {
+  value = length(local.result)
+}
+
+output "first" {
+  value = local.result[0]
+}
+
+output "second" {
+  value = local.result[1]
+}
+`, pattern)
+}
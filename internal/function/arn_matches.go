@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = arnMatchesFunction{}
+
+func NewARNMatchesFunction() function.Function {
+	return &arnMatchesFunction{}
+}
+
+type arnMatchesFunction struct{}
+
+func (f arnMatchesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_matches"
+}
+
+func (f arnMatchesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "arn_matches Function",
+		MarkdownDescription: "Checks whether an ARN matches an IAM-style ARN pattern. " +
+			"The `*` and `?` wildcards are evaluated separately for each ARN segment",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "ARN pattern, which may contain `*` and `?` wildcards",
+			},
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name) to match",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f arnMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pattern, arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	p, err := parseARNPattern(pattern)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	a, err := arn.Parse(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, p.matches(a)))
+}
+
+// arnPattern is an IAM-style ARN pattern.
+type arnPattern struct {
+	arn arn.ARN
+	// matchAll is set for the `*` pattern, which matches all ARNs.
+	matchAll bool
+}
+
+// parseARNPattern parses an ARN pattern.
+// Patterns are parsed in the same way as ARNs, so each wildcard applies only within its segment.
+func parseARNPattern(s string) (arnPattern, error) {
+	if s == "*" {
+		return arnPattern{matchAll: true}, nil
+	}
+
+	a, err := arn.Parse(s)
+	if err != nil {
+		return arnPattern{}, err
+	}
+
+	return arnPattern{arn: a}, nil
+}
+
+func (p arnPattern) matches(a arn.ARN) bool {
+	if p.matchAll {
+		return true
+	}
+
+	return wildcardMatch(p.arn.Partition, a.Partition) &&
+		wildcardMatch(p.arn.Service, a.Service) &&
+		wildcardMatch(p.arn.Region, a.Region) &&
+		wildcardMatch(p.arn.AccountID, a.AccountID) &&
+		wildcardMatch(p.arn.Resource, a.Resource)
+}
+
+// wildcardMatch reports whether s matches the pattern using IAM wildcard semantics:
+// `*` matches any sequence of characters, including the empty sequence, and `?` matches any single character.
+// Matching is case-sensitive.
+func wildcardMatch(pattern, s string) bool {
+	p, pr := []rune(pattern), []rune(s)
+	i, j := 0, 0
+	star, mark := -1, 0
+
+	for j < len(pr) {
+		switch {
+		case i < len(p) && (p[i] == '?' || p[i] == pr[j]):
+			i++
+			j++
+		case i < len(p) && p[i] == '*':
+			star, mark = i, j
+			i++
+		case star != -1:
+			// Backtrack: let the last `*` consume one more character.
+			i = star + 1
+			mark++
+			j = mark
+		default:
+			return false
+		}
+	}
+
+	for i < len(p) && p[i] == '*' {
+		i++
+	}
+
+	return i == len(p)
+}
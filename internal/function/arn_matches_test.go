@@ -0,0 +1,289 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestWildcardMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern string
+		s       string
+		want    bool
+	}{
+		{"", "", true},
+		{"", "a", false},
+		{"a", "", false},
+		{"*", "", true},
+		{"*", "anything", true},
+		{"**", "anything", true},
+		{"?", "", false},
+		{"?", "a", true},
+		{"?", "ab", false},
+		{"??", "ab", true},
+		{"abc", "abc", true},
+		{"abc", "ABC", false},
+		{"abc", "abcd", false},
+		{"a*", "a", true},
+		{"a*", "abc", true},
+		{"a*", "ba", false},
+		{"*c", "abc", true},
+		{"*c", "abd", false},
+		{"a*c", "ac", true},
+		{"a*c", "abbbc", true},
+		{"a*c", "abbbd", false},
+		{"a?c", "abc", true},
+		{"a?c", "ac", false},
+		{"a*b*c", "aXbYc", true},
+		{"a*b*c", "aXcYb", false},
+		{"*a*a*", "banana", true},
+		{"*x*", "banana", false},
+		{"a*?", "a", false},
+		{"a*?", "ab", true},
+		{"my-bucket/*", "my-bucket/", true},
+		{"my-bucket/*", "my-bucket/a/b/c", true},
+		{"my-bucket/*", "my-bucket", false},
+		{"my-bucket*", "my-bucket-logs", true},
+		{"user/*/home", "user/a/b/home", true},
+		{"ü?", "üß", true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(fmt.Sprintf("%q %q", testCase.pattern, testCase.s), func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tffunction.WildcardMatch(testCase.pattern, testCase.s), testCase.want; got != want {
+				t.Errorf("WildcardMatch(%q, %q) = %v, want %v", testCase.pattern, testCase.s, got, want)
+			}
+		})
+	}
+}
+
+func TestARNPatternMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pattern string
+		arn     string
+		want    bool
+		wantErr bool
+	}{
+		"exact": {
+			pattern: "arn:aws:iam::444455556666:role/example",
+			arn:     "arn:aws:iam::444455556666:role/example",
+			want:    true,
+		},
+		"exact mismatch": {
+			pattern: "arn:aws:iam::444455556666:role/example",
+			arn:     "arn:aws:iam::444455556666:role/example2",
+		},
+		"match all": {
+			pattern: "*",
+			arn:     "arn:aws:iam::444455556666:role/example",
+			want:    true,
+		},
+		"all segments wildcard": {
+			pattern: "arn:*:*:*:*:*",
+			arn:     "arn:aws:sqs:us-west-2:444455556666:example", //lintignore:AWSAT003,AWSAT005
+			want:    true,
+		},
+		"S3 object": {
+			pattern: "arn:aws:s3:::my-bucket/*",
+			arn:     "arn:aws:s3:::my-bucket/path/to/object",
+			want:    true,
+		},
+		"S3 bucket not matched by object pattern": {
+			pattern: "arn:aws:s3:::my-bucket/*",
+			arn:     "arn:aws:s3:::my-bucket",
+		},
+		"S3 other bucket": {
+			pattern: "arn:aws:s3:::my-bucket/*",
+			arn:     "arn:aws:s3:::my-bucket2/object",
+		},
+		"partition wildcard": {
+			pattern: "arn:aws*:s3:::my-bucket",
+			arn:     "arn:aws-us-gov:s3:::my-bucket", //lintignore:AWSAT005
+			want:    true,
+		},
+		"partition mismatch": {
+			pattern: "arn:aws:s3:::my-bucket",
+			arn:     "arn:aws-cn:s3:::my-bucket", //lintignore:AWSAT005
+		},
+		"service wildcard": {
+			pattern: "arn:aws:s?s:us-west-2:444455556666:example", //lintignore:AWSAT003,AWSAT005
+			arn:     "arn:aws:sns:us-west-2:444455556666:example", //lintignore:AWSAT003,AWSAT005
+			want:    true,
+		},
+		"region wildcard": {
+			pattern: "arn:aws:sqs:*:444455556666:example",
+			arn:     "arn:aws:sqs:eu-west-1:444455556666:example", //lintignore:AWSAT003,AWSAT005
+			want:    true,
+		},
+		"region partial wildcard": {
+			pattern: "arn:aws:sqs:us-*:444455556666:example",      //lintignore:AWSAT005
+			arn:     "arn:aws:sqs:eu-west-1:444455556666:example", //lintignore:AWSAT003,AWSAT005
+		},
+		"region single character wildcard": {
+			pattern: "arn:aws:sqs:us-west-?:444455556666:example", //lintignore:AWSAT003,AWSAT005
+			arn:     "arn:aws:sqs:us-west-2:444455556666:example", //lintignore:AWSAT003,AWSAT005
+			want:    true,
+		},
+		"empty region does not match wildcard region with characters": {
+			pattern: "arn:aws:s3:?:*:my-bucket",
+			arn:     "arn:aws:s3:::my-bucket",
+		},
+		"empty region matches star": {
+			pattern: "arn:aws:s3:*:*:my-bucket",
+			arn:     "arn:aws:s3:::my-bucket",
+			want:    true,
+		},
+		"account wildcard": {
+			pattern: "arn:aws:iam::*:role/example",
+			arn:     "arn:aws:iam::444455556666:role/example",
+			want:    true,
+		},
+		"account mismatch": {
+			pattern: "arn:aws:iam::111122223333:role/example",
+			arn:     "arn:aws:iam::444455556666:role/example",
+		},
+		"wildcard does not span segments": {
+			pattern: "arn:aws:iam:*::role/example",
+			arn:     "arn:aws:iam::444455556666:role/example",
+		},
+		"resource wildcard spans colons": {
+			pattern: "arn:aws:logs:us-west-2:444455556666:log-group:example:*",                  //lintignore:AWSAT003,AWSAT005
+			arn:     "arn:aws:logs:us-west-2:444455556666:log-group:example:log-stream:example", //lintignore:AWSAT003,AWSAT005
+			want:    true,
+		},
+		"resource case-sensitive": {
+			pattern: "arn:aws:iam::444455556666:role/Example",
+			arn:     "arn:aws:iam::444455556666:role/example",
+		},
+		"role path wildcard": {
+			pattern: "arn:aws:iam::444455556666:role/*/example",
+			arn:     "arn:aws:iam::444455556666:role/with/path/example",
+			want:    true,
+		},
+		"invalid pattern": {
+			pattern: "invalid",
+			arn:     "arn:aws:iam::444455556666:role/example",
+			wantErr: true,
+		},
+		"invalid ARN": {
+			pattern: "arn:aws:iam::444455556666:role/example",
+			arn:     "invalid",
+			wantErr: true,
+		},
+		"match all invalid ARN": {
+			pattern: "*",
+			arn:     "invalid",
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tffunction.ARNPatternMatches(testCase.pattern, testCase.arn)
+			if (err != nil) != testCase.wantErr {
+				t.Fatalf("unexpected error: %v, wantErr %v", err, testCase.wantErr)
+			}
+			if got != testCase.want {
+				t.Errorf("ARNPatternMatches(%q, %q) = %v, want %v", testCase.pattern, testCase.arn, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestARNMatchesFunction_match(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNMatchesFunctionConfig("arn:aws:s3:::my-bucket/*", "arn:aws:s3:::my-bucket/object"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestARNMatchesFunction_noMatch(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNMatchesFunctionConfig("arn:aws:s3:::my-bucket/*", "arn:aws:s3:::my-bucket"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestARNMatchesFunction_invalidPattern(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testARNMatchesFunctionConfig("invalid", "arn:aws:s3:::my-bucket"),
+				ExpectError: regexache.MustCompile("arn: invalid prefix"),
+			},
+		},
+	})
+}
+
+func TestARNMatchesFunction_invalidARN(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testARNMatchesFunctionConfig("arn:aws:s3:::my-bucket", "invalid"),
+				ExpectError: regexache.MustCompile("arn: invalid prefix"),
+			},
+		},
+	})
+}
+
+func testARNMatchesFunctionConfig(pattern, arn string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::arn_matches(%[1]q, %[2]q)
+}
+`, pattern, arn)
+}
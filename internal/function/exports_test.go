@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// Exports for use in tests only.
+var (
+	TagsAll       = tagsAll
+	WildcardMatch = wildcardMatch
+)
+
+func ARNPatternMatches(pattern, s string) (bool, error) {
+	p, err := parseARNPattern(pattern)
+	if err != nil {
+		return false, err
+	}
+
+	a, err := arn.Parse(s)
+	if err != nil {
+		return false, err
+	}
+
+	return p.matches(a), nil
+}
+
+func ParseS3URI(s string) (map[string]string, error) {
+	v, err := parseS3URI(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{
+		"bucket":               v.bucket,
+		"bucket_type":          v.bucketType,
+		"key":                  v.key,
+		"version_id":           v.versionID,
+		"availability_zone_id": v.availabilityZoneID,
+	}, nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+var _ function.Function = s3URIBuildFunction{}
+
+func NewS3URIBuildFunction() function.Function {
+	return &s3URIBuildFunction{}
+}
+
+type s3URIBuildFunction struct{}
+
+func (f s3URIBuildFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "s3_uri_build"
+}
+
+func (f s3URIBuildFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "s3_uri_build Function",
+		MarkdownDescription: "Builds an S3 URI from its constituent parts",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "bucket",
+				MarkdownDescription: "Bucket name, access point or Object Lambda access point alias, or access point ARN",
+			},
+			function.StringParameter{
+				Name:                "key",
+				MarkdownDescription: "Object key. May be empty",
+			},
+			function.StringParameter{
+				Name:                "version_id",
+				MarkdownDescription: "Object version ID. May be empty",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f s3URIBuildFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var bucket, key, versionID string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &bucket, &key, &versionID))
+	if resp.Error != nil {
+		return
+	}
+
+	if versionID != "" && key == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, "version ID requires an object key"))
+		return
+	}
+
+	if err := validateS3URIBucket(bucket); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result := s3URIScheme + bucket
+	if key != "" {
+		result += "/" + key
+	}
+	if versionID != "" {
+		result += s3URIVersionIDPrefix + versionID
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// validateS3URIBucket validates the bucket of an S3 URI.
+func validateS3URIBucket(bucket string) error {
+	if arn.IsARN(bucket) {
+		_, key, err := parseS3AccessPointARN(bucket)
+		if err != nil {
+			return err
+		}
+		if key != "" {
+			return fmt.Errorf("invalid access point ARN: %s", bucket)
+		}
+
+		return nil
+	}
+
+	if _, key, err := fwvalidators.ParseS3URI(s3URIScheme + bucket); err != nil || key != "" {
+		return fmt.Errorf("invalid bucket name: %s", bucket)
+	}
+
+	return nil
+}
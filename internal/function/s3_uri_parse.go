@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+)
+
+const (
+	s3URIScheme          = "s3://"
+	s3URIVersionIDPrefix = "?versionId="
+)
+
+const (
+	s3BucketTypeAccessPoint                  = "access_point"
+	s3BucketTypeAccessPointAlias             = "access_point_alias"
+	s3BucketTypeDirectory                    = "directory"
+	s3BucketTypeGeneralPurpose               = "general_purpose"
+	s3BucketTypeMultiRegionAccessPoint       = "multi_region_access_point"
+	s3BucketTypeObjectLambdaAccessPoint      = "object_lambda_access_point"
+	s3BucketTypeObjectLambdaAccessPointAlias = "object_lambda_access_point_alias"
+)
+
+var s3URIParseResultAttrTypes = map[string]attr.Type{
+	"bucket":               types.StringType,
+	"bucket_type":          types.StringType,
+	"key":                  types.StringType,
+	"version_id":           types.StringType,
+	"availability_zone_id": types.StringType,
+}
+
+var _ function.Function = s3URIParseFunction{}
+
+func NewS3URIParseFunction() function.Function {
+	return &s3URIParseFunction{}
+}
+
+type s3URIParseFunction struct{}
+
+func (f s3URIParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "s3_uri_parse"
+}
+
+func (f s3URIParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "s3_uri_parse Function",
+		MarkdownDescription: "Parses an S3 URI into its constituent parts. " +
+			"The bucket may be a general purpose or directory bucket name, an access point or Object Lambda access point alias, or an access point ARN",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "uri",
+				MarkdownDescription: "S3 URI (s3://bucket[/key][?versionId=version]) to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: s3URIParseResultAttrTypes,
+		},
+	}
+}
+
+func (f s3URIParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	uri, err := parseS3URI(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"bucket":               types.StringValue(uri.bucket),
+		"bucket_type":          types.StringValue(uri.bucketType),
+		"key":                  types.StringValue(uri.key),
+		"version_id":           types.StringValue(uri.versionID),
+		"availability_zone_id": types.StringValue(uri.availabilityZoneID),
+	}
+
+	result, d := types.ObjectValue(s3URIParseResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+type s3URI struct {
+	availabilityZoneID string
+	bucket             string
+	bucketType         string
+	key                string
+	versionID          string
+}
+
+// parseS3URI parses an S3 URI of the form s3://bucket[/key][?versionId=version].
+// The bucket may be an access point ARN, in which case the key follows the access point name.
+func parseS3URI(s string) (s3URI, error) {
+	var result s3URI
+
+	if i := strings.LastIndex(s, s3URIVersionIDPrefix); i >= 0 {
+		s, result.versionID = s[:i], s[i+len(s3URIVersionIDPrefix):]
+		if result.versionID == "" {
+			return s3URI{}, errors.New("invalid S3 URI: empty version ID")
+		}
+	}
+
+	if v := strings.TrimPrefix(s, s3URIScheme); arn.IsARN(v) {
+		bucketARN, key, err := parseS3AccessPointARN(v)
+		if err != nil {
+			return s3URI{}, err
+		}
+
+		result.bucket, result.key = bucketARN.String(), key
+		result.bucketType = s3BucketTypeForAccessPointARN(bucketARN)
+	} else {
+		bucket, key, err := fwvalidators.ParseS3URI(s)
+		if err != nil {
+			return s3URI{}, err
+		}
+
+		result.bucket, result.key = bucket, key
+		result.bucketType, result.availabilityZoneID = s3BucketTypeForName(bucket)
+	}
+
+	if result.versionID != "" && result.key == "" {
+		return s3URI{}, errors.New("invalid S3 URI: version ID without object key")
+	}
+
+	return result, nil
+}
+
+// parseS3AccessPointARN parses an access point ARN, optionally followed by an object key.
+func parseS3AccessPointARN(s string) (arn.ARN, string, error) {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return arn.ARN{}, "", err
+	}
+
+	switch v.Service {
+	case "s3", "s3-object-lambda":
+	default:
+		return arn.ARN{}, "", fmt.Errorf("unsupported access point ARN service: %s", v.Service)
+	}
+
+	// e.g. accesspoint/example/path/to/key
+	parts := strings.SplitN(v.Resource, "/", 3)
+	if len(parts) < 2 || parts[0] != "accesspoint" || parts[1] == "" {
+		return arn.ARN{}, "", fmt.Errorf("unsupported access point ARN resource: %s", v.Resource)
+	}
+
+	v.Resource = parts[0] + "/" + parts[1]
+	var key string
+	if len(parts) == 3 {
+		key = parts[2]
+	}
+
+	return v, key, nil
+}
+
+func s3BucketTypeForAccessPointARN(v arn.ARN) string {
+	switch {
+	case v.Service == "s3-object-lambda":
+		return s3BucketTypeObjectLambdaAccessPoint
+	case v.Region == "":
+		return s3BucketTypeMultiRegionAccessPoint
+	default:
+		return s3BucketTypeAccessPoint
+	}
+}
+
+// s3BucketTypeForName returns the bucket type and, for directory buckets, Availability Zone ID of a bucket name.
+func s3BucketTypeForName(bucket string) (string, string) {
+	if m := tfs3.DirectoryBucketNameRegex.FindStringSubmatch(bucket); m != nil {
+		return s3BucketTypeDirectory, m[2]
+	}
+
+	switch {
+	case strings.HasSuffix(bucket, "--ol-s3"):
+		return s3BucketTypeObjectLambdaAccessPointAlias, ""
+	case strings.HasSuffix(bucket, "-s3alias"):
+		return s3BucketTypeAccessPointAlias, ""
+	default:
+		return s3BucketTypeGeneralPurpose, ""
+	}
+}
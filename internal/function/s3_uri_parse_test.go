@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestParseS3URI(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		uri     string
+		want    map[string]string
+		wantErr bool
+	}{
+		"bucket": {
+			uri: "s3://example",
+			want: map[string]string{
+				"bucket":               "example",
+				"bucket_type":          "general_purpose",
+				"key":                  "",
+				"version_id":           "",
+				"availability_zone_id": "",
+			},
+		},
+		"bucket and key": {
+			uri: "s3://example/path/to/key",
+			want: map[string]string{
+				"bucket":               "example",
+				"bucket_type":          "general_purpose",
+				"key":                  "path/to/key",
+				"version_id":           "",
+				"availability_zone_id": "",
+			},
+		},
+		"version": {
+			uri: "s3://example/key?versionId=3HL4kqtJlcpXroDTDmJ.rmSpXd3dIbrHY",
+			want: map[string]string{
+				"bucket":               "example",
+				"bucket_type":          "general_purpose",
+				"key":                  "key",
+				"version_id":           "3HL4kqtJlcpXroDTDmJ.rmSpXd3dIbrHY",
+				"availability_zone_id": "",
+			},
+		},
+		"directory bucket": {
+			uri: "s3://example--usw2-az2--x-s3/key",
+			want: map[string]string{
+				"bucket":               "example--usw2-az2--x-s3",
+				"bucket_type":          "directory",
+				"key":                  "key",
+				"version_id":           "",
+				"availability_zone_id": "usw2-az2",
+			},
+		},
+		"access point alias": {
+			uri: "s3://example-hrzrlukc5m36ft7okagglf3gmwluquse1b-s3alias/key",
+			want: map[string]string{
+				"bucket":               "example-hrzrlukc5m36ft7okagglf3gmwluquse1b-s3alias",
+				"bucket_type":          "access_point_alias",
+				"key":                  "key",
+				"version_id":           "",
+				"availability_zone_id": "",
+			},
+		},
+		"Object Lambda access point alias": {
+			uri: "s3://example-yee2ighudzkbnvxkeqpl7w8sn5dnnusw2a--ol-s3/key",
+			want: map[string]string{
+				"bucket":               "example-yee2ighudzkbnvxkeqpl7w8sn5dnnusw2a--ol-s3",
+				"bucket_type":          "object_lambda_access_point_alias",
+				"key":                  "key",
+				"version_id":           "",
+				"availability_zone_id": "",
+			},
+		},
+		"access point ARN": {
+			uri: "s3://arn:aws:s3:us-west-2:444455556666:accesspoint/example/path/to/key", //lintignore:AWSAT003,AWSAT005
+			want: map[string]string{
+				"bucket":               "arn:aws:s3:us-west-2:444455556666:accesspoint/example", //lintignore:AWSAT003,AWSAT005
+				"bucket_type":          "access_point",
+				"key":                  "path/to/key",
+				"version_id":           "",
+				"availability_zone_id": "",
+			},
+		},
+		"Object Lambda access point ARN": {
+			uri: "s3://arn:aws:s3-object-lambda:us-west-2:444455556666:accesspoint/example", //lintignore:AWSAT003,AWSAT005
+			want: map[string]string{
+				"bucket":               "arn:aws:s3-object-lambda:us-west-2:444455556666:accesspoint/example", //lintignore:AWSAT003,AWSAT005
+				"bucket_type":          "object_lambda_access_point",
+				"key":                  "",
+				"version_id":           "",
+				"availability_zone_id": "",
+			},
+		},
+		"Multi-Region access point ARN": {
+			uri: "s3://arn:aws:s3::444455556666:accesspoint/mfzwi23gnjvgw.mrap/key", //lintignore:AWSAT005
+			want: map[string]string{
+				"bucket":               "arn:aws:s3::444455556666:accesspoint/mfzwi23gnjvgw.mrap", //lintignore:AWSAT005
+				"bucket_type":          "multi_region_access_point",
+				"key":                  "key",
+				"version_id":           "",
+				"availability_zone_id": "",
+			},
+		},
+		"no scheme": {
+			uri:     "example/key",
+			wantErr: true,
+		},
+		"invalid bucket": {
+			uri:     "s3://Example/key",
+			wantErr: true,
+		},
+		"empty version": {
+			uri:     "s3://example/key?versionId=",
+			wantErr: true,
+		},
+		"version without key": {
+			uri:     "s3://example?versionId=3HL4kqtJlcpXroDTDmJ.rmSpXd3dIbrHY",
+			wantErr: true,
+		},
+		"unsupported ARN service": {
+			uri:     "s3://arn:aws:iam::444455556666:role/example", //lintignore:AWSAT005
+			wantErr: true,
+		},
+		"unsupported ARN resource": {
+			uri:     "s3://arn:aws:s3:::example/key", //lintignore:AWSAT005
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tffunction.ParseS3URI(testCase.uri)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("ParseS3URI(%q) err %t, want %t", testCase.uri, got, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestS3URIParseFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("s3://example--usw2-az2--x-s3/path/to/key"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("bucket", "example--usw2-az2--x-s3"),
+					resource.TestCheckOutput("bucket_type", "directory"),
+					resource.TestCheckOutput("key", "path/to/key"),
+					resource.TestCheckOutput("version_id", ""),
+					resource.TestCheckOutput("availability_zone_id", "usw2-az2"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testS3URIParseFunctionConfig("https://example.s3.amazonaws.com/key"),
+				ExpectError: regexache.MustCompile("invalid S3 URI"),
+			},
+		},
+	})
+}
+
+func testS3URIParseFunctionConfig(uri string) string {
+	return fmt.Sprintf(`
+locals {
+  result = provider::aws::s3_uri_parse(%[1]q)
+}
+
+output "bucket" {
+  value = local.result.bucket
+}
+
+output "bucket_type" {
+  value = local.result.bucket_type
+}
+
+output "key" {
+  value = local.result.key
+}
+
+output "version_id" {
+  value = local.result.version_id
+}
+
+output "availability_zone_id" {
+  value = local.result.availability_zone_id
+}
+`, uri)
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+var _ function.Function = tagsAllFunction{}
+
+func NewTagsAllFunction() function.Function {
+	return &tagsAllFunction{}
+}
+
+type tagsAllFunction struct{}
+
+func (f tagsAllFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_all"
+}
+
+func (f tagsAllFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "tags_all Function",
+		MarkdownDescription: "Returns the effective set of tags, `tags_all`, of a resource from its `tags` and the provider's `default_tags` and `ignore_tags` configuration. " +
+			"Tags are merged exactly as they are by the provider",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "resource_tags",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+				MarkdownDescription: "Resource `tags`",
+			},
+			function.MapParameter{
+				Name:                "default_tags",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+				MarkdownDescription: "Provider `default_tags` `tags`",
+			},
+			function.ObjectParameter{
+				Name: "ignore_config",
+				AttributeTypes: map[string]attr.Type{
+					"keys":         types.SetType{ElemType: types.StringType},
+					"key_prefixes": types.SetType{ElemType: types.StringType},
+				},
+				AllowNullValue:      true,
+				MarkdownDescription: "Provider `ignore_tags` configuration, an object with `keys` and `key_prefixes` attributes",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+type tagsAllIgnoreConfig struct {
+	Keys        types.Set `tfsdk:"keys"`
+	KeyPrefixes types.Set `tfsdk:"key_prefixes"`
+}
+
+func (f tagsAllFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceTags, defaultTags types.Map
+	var ignoreConfig types.Object
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &resourceTags, &defaultTags, &ignoreConfig))
+	if resp.Error != nil {
+		return
+	}
+
+	var resourceTagsMap, defaultTagsMap map[string]string
+	if diags := resourceTags.ElementsAs(ctx, &resourceTagsMap, false); diags.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+		return
+	}
+	if diags := defaultTags.ElementsAs(ctx, &defaultTagsMap, false); diags.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+		return
+	}
+
+	var keys, keyPrefixes []string
+	if !ignoreConfig.IsNull() {
+		var config tagsAllIgnoreConfig
+		if diags := ignoreConfig.As(ctx, &config, basetypes.ObjectAsOptions{}); diags.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+			return
+		}
+		if diags := config.Keys.ElementsAs(ctx, &keys, false); diags.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+			return
+		}
+		if diags := config.KeyPrefixes.ElementsAs(ctx, &keyPrefixes, false); diags.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+			return
+		}
+	}
+
+	result := tagsAll(ctx, resourceTagsMap, defaultTagsMap, keys, keyPrefixes)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// tagsAll returns the effective tags of a resource.
+// Default tags are overridden by resource tags with the same key, then AWS-reserved and ignored tags are removed.
+func tagsAll(ctx context.Context, resourceTags, defaultTags map[string]string, ignoreKeys, ignoreKeyPrefixes []string) map[string]string {
+	var defaultConfig *tftags.DefaultConfig
+	if len(defaultTags) > 0 {
+		defaultConfig = &tftags.DefaultConfig{
+			Tags: tftags.New(ctx, defaultTags),
+		}
+	}
+
+	var ignoreConfig *tftags.IgnoreConfig
+	if len(ignoreKeys) > 0 || len(ignoreKeyPrefixes) > 0 {
+		ignoreConfig = &tftags.IgnoreConfig{}
+		if len(ignoreKeys) > 0 {
+			ignoreConfig.Keys = tftags.New(ctx, ignoreKeys)
+		}
+		if len(ignoreKeyPrefixes) > 0 {
+			ignoreConfig.KeyPrefixes = tftags.New(ctx, ignoreKeyPrefixes)
+		}
+	}
+
+	return defaultConfig.MergeTags(tftags.New(ctx, resourceTags)).IgnoreAWS().IgnoreConfig(ignoreConfig).Map()
+}
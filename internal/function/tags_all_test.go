@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestTagsAll(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resourceTags      map[string]string
+		defaultTags       map[string]string
+		ignoreKeys        []string
+		ignoreKeyPrefixes []string
+		want              map[string]string
+	}{
+		"empty": {
+			want: map[string]string{},
+		},
+		"resource tags only": {
+			resourceTags: map[string]string{"Name": "example"},
+			want:         map[string]string{"Name": "example"},
+		},
+		"default tags only": {
+			defaultTags: map[string]string{"Environment": "test"},
+			want:        map[string]string{"Environment": "test"},
+		},
+		"resource tags override default tags": {
+			resourceTags: map[string]string{"Name": "example", "Environment": "prod"},
+			defaultTags:  map[string]string{"Environment": "test", "Owner": "team"},
+			want:         map[string]string{"Name": "example", "Environment": "prod", "Owner": "team"},
+		},
+		"empty values": {
+			resourceTags: map[string]string{"Name": ""},
+			defaultTags:  map[string]string{"Owner": ""},
+			want:         map[string]string{"Name": "", "Owner": ""},
+		},
+		"AWS tags": {
+			resourceTags: map[string]string{"Name": "example", "aws:cloudformation:stack-name": "stack"},
+			defaultTags:  map[string]string{"aws:createdBy": "someone"},
+			want:         map[string]string{"Name": "example"},
+		},
+		"ignored keys": {
+			resourceTags: map[string]string{"Name": "example", "LastScanned": "today"},
+			defaultTags:  map[string]string{"Owner": "team"},
+			ignoreKeys:   []string{"LastScanned", "Owner"},
+			want:         map[string]string{"Name": "example"},
+		},
+		"ignored key prefixes": {
+			resourceTags:      map[string]string{"Name": "example", "kubernetes.io/cluster/test": "owned"},
+			defaultTags:       map[string]string{"kubernetes.io/role/elb": "1"},
+			ignoreKeyPrefixes: []string{"kubernetes.io/"},
+			want:              map[string]string{"Name": "example"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tffunction.TagsAll(context.Background(), testCase.resourceTags, testCase.defaultTags, testCase.ignoreKeys, testCase.ignoreKeyPrefixes)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTagsAllFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsAllFunctionConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "3"),
+					resource.TestCheckOutput("name", "example"),
+					resource.TestCheckOutput("environment", "prod"),
+					resource.TestCheckOutput("owner", "team"),
+				),
+			},
+		},
+	})
+}
+
+func TestTagsAllFunction_ignoreConfig(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsAllFunctionConfig_ignoreConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "1"),
+					resource.TestCheckOutput("name", "example"),
+				),
+			},
+		},
+	})
+}
+
+func TestTagsAllFunction_null(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsAllFunctionConfig_null(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testTagsAllFunctionConfig_basic() string {
+	return `
+locals {
+  result = provider::aws::tags_all(
+    {
+      Name        = "example"
+      Environment = "prod"
+    },
+    {
+      Environment = "test"
+      Owner       = "team"
+    },
+    null,
+  )
+}
+
+output "count" {
+  value = length(local.result)
+}
+
+output "name" {
+  value = local.result["Name"]
+}
+
+output "environment" {
+  value = local.result["Environment"]
+}
+
+output "owner" {
+  value = local.result["Owner"]
+}
+`
+}
+
+func testTagsAllFunctionConfig_ignoreConfig() string {
+	return `
+locals {
+  result = provider::aws::tags_all(
+    {
+      Name                          = "example"
+      LastScanned                   = "today"
+      "aws:cloudformation:stack-id" = "stack"
+    },
+    {
+      "kubernetes.io/role/elb" = "1"
+    },
+    {
+      keys         = ["LastScanned"]
+      key_prefixes = ["kubernetes.io/"]
+    },
+  )
+}
+
+output "count" {
+  value = length(local.result)
+}
+
+output "name" {
+  value = local.result["Name"]
+}
+`
+}
+
+func testTagsAllFunctionConfig_null() string {
+	return `
+locals {
+  result = provider::aws::tags_all(null, null, null)
+}
+
+output "count" {
+  value = length(local.result)
+}
+`
+}
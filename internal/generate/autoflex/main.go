@@ -0,0 +1,1178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/YakDriver/regexache"
+	pluralize "github.com/gertd/go-pluralize"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	filename     = `autoflex_gen.go`
+	testFilename = `autoflex_gen_test.go`
+
+	attrPackagePath      = "github.com/hashicorp/terraform-plugin-framework/attr"
+	basetypesPackagePath = "github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	flexPackagePath      = "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	flextestPackagePath  = "github.com/hashicorp/terraform-provider-aws/internal/framework/flex/flextest"
+	fwtypesPackagePath   = "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	typesPackagePath     = "github.com/hashicorp/terraform-plugin-framework/types"
+
+	mapBlockKeyFieldName = "MapBlockKey"
+)
+
+var (
+	annotation = regexache.MustCompile(`^//\s*@([0-9A-Za-z]+)(\(([^)]*)\))?\s*$`)
+	plural     = pluralize.NewClient()
+)
+
+func main() {
+	g := common.NewGenerator()
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating internal/service/%s/%s", servicePackage, filename)
+
+	u, err := load(servicePackage)
+	if err != nil {
+		g.Fatalf("loading packages: %s", err)
+	}
+
+	roots, err := u.annotations()
+	if err != nil {
+		g.Fatalf("parsing annotations: %s", err)
+	}
+	if len(roots) == 0 {
+		g.Fatalf("no @AutoFlexExpand or @AutoFlexFlatten annotations found")
+	}
+
+	gen := newGenerator(u)
+	for _, root := range roots {
+		root.function, err = gen.enqueue(root.pair)
+		if err != nil {
+			g.Fatalf("%s", err)
+		}
+	}
+	if err := gen.run(); err != nil {
+		g.Fatalf("%s", err)
+	}
+
+	body, err := gen.source(servicePackage)
+	if err != nil {
+		g.Fatalf("formatting generated source: %s", err)
+	}
+	d := g.NewGoFileDestination(filename)
+	if err := d.WriteBytes(body); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	g.Infof("Generating internal/service/%s/%s", servicePackage, testFilename)
+
+	body, err = testSource(servicePackage, roots)
+	if err != nil {
+		g.Fatalf("formatting generated test source: %s", err)
+	}
+	d = g.NewGoFileDestination(testFilename)
+	if err := d.WriteBytes(body); err != nil {
+		g.Fatalf("generating file (%s): %s", testFilename, err)
+	}
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", testFilename, err)
+	}
+}
+
+// direction is the direction of an AutoFlex conversion.
+type direction int
+
+const (
+	expand  direction = iota // Terraform --> AWS.
+	flatten                  // AWS --> Terraform.
+)
+
+func (d direction) String() string {
+	if d == expand {
+		return "Expand"
+	}
+	return "Flatten"
+}
+
+// options mirrors flex.AutoFlexOptions.
+type options struct {
+	fieldNamePrefix     string
+	fieldNameSuffix     string
+	ignoredFieldNames   []string
+	noIgnoredFieldNames bool
+}
+
+func (o options) isIgnoredField(s string) bool {
+	if o.noIgnoredFieldNames {
+		return false
+	}
+	return s == "Tags" || slices.Contains(o.ignoredFieldNames, s)
+}
+
+// funcs returns the Go source of the equivalent flex.AutoFlexOptionsFunc arguments.
+func (o options) funcs() string {
+	var args []string
+	if o.fieldNamePrefix != "" {
+		args = append(args, fmt.Sprintf("fwflex.WithFieldNamePrefix(%q)", o.fieldNamePrefix))
+	}
+	if o.fieldNameSuffix != "" {
+		args = append(args, fmt.Sprintf("fwflex.WithFieldNameSuffix(%q)", o.fieldNameSuffix))
+	}
+	if o.noIgnoredFieldNames {
+		args = append(args, "fwflex.WithNoIgnoredFieldNames()")
+	}
+	for _, v := range o.ignoredFieldNames {
+		args = append(args, fmt.Sprintf("fwflex.WithIgnoredFieldNamesAppend(%q)", v))
+	}
+	return strings.Join(args, ", ")
+}
+
+func (o options) equal(other options) bool {
+	return o.fieldNamePrefix == other.fieldNamePrefix &&
+		o.fieldNameSuffix == other.fieldNameSuffix &&
+		o.noIgnoredFieldNames == other.noIgnoredFieldNames &&
+		slices.Equal(o.ignoredFieldNames, other.ignoredFieldNames)
+}
+
+// pair is a conversion between a Terraform model struct and an AWS API struct.
+type pair struct {
+	direction direction
+	from, to  *types.Named
+	options   options
+}
+
+func (p pair) key() string {
+	return fmt.Sprintf("%s:%s:%s", p.direction, p.from, p.to)
+}
+
+// root is an annotated conversion.
+type root struct {
+	pair
+	function string
+}
+
+// valuable is a basetypes Valuable interface, in the order that flex's expander checks them.
+type valuable int
+
+const (
+	valuableNone valuable = iota
+	valuableBool
+	valuableFloat64
+	valuableFloat32
+	valuableInt64
+	valuableInt32
+	valuableString
+	valuableObject
+	valuableList
+	valuableMap
+	valuableSet
+)
+
+// universe holds the loaded packages and the well-known types that the generator needs.
+type universe struct {
+	pkg *packages.Package
+
+	attrValue     *types.Interface
+	expander      *types.Interface
+	typedExpander *types.Interface
+	flattener     *types.Interface
+	valuables     []*types.Interface // Indexed by valuable - 1.
+
+	listNestedObjectValueOf *types.TypeName
+	setNestedObjectValueOf  *types.TypeName
+
+	boolValue    types.Type
+	float64Value types.Type
+	int64Value   types.Type
+	stringValue  types.Type
+}
+
+func load(servicePackage string) (*universe, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedImports,
+		// Previously generated code may no longer compile, so don't load it.
+		Overlay: map[string][]byte{
+			filepath.Join(wd, filename): []byte("package " + servicePackage),
+		},
+	}
+	pkgs, err := packages.Load(cfg, ".", attrPackagePath, basetypesPackagePath, flexPackagePath, fwtypesPackagePath)
+	if err != nil {
+		return nil, err
+	}
+
+	byPath := make(map[string]*packages.Package)
+	u := &universe{}
+	for _, pkg := range pkgs {
+		byPath[pkg.PkgPath] = pkg
+		if pkg.Name == servicePackage {
+			u.pkg = pkg
+		}
+	}
+	if u.pkg == nil || u.pkg.Types == nil {
+		return nil, fmt.Errorf("package %s not loaded", servicePackage)
+	}
+
+	lookup := func(path, name string) (types.Object, error) {
+		pkg, ok := byPath[path]
+		if !ok || pkg.Types == nil {
+			return nil, fmt.Errorf("package %s not loaded", path)
+		}
+		obj := pkg.Types.Scope().Lookup(name)
+		if obj == nil {
+			return nil, fmt.Errorf("%s.%s not found", path, name)
+		}
+		return obj, nil
+	}
+	iface := func(path, name string) (*types.Interface, error) {
+		obj, err := lookup(path, name)
+		if err != nil {
+			return nil, err
+		}
+		v, ok := obj.Type().Underlying().(*types.Interface)
+		if !ok {
+			return nil, fmt.Errorf("%s.%s is not an interface", path, name)
+		}
+		return v, nil
+	}
+	typ := func(path, name string) (types.Type, error) {
+		obj, err := lookup(path, name)
+		if err != nil {
+			return nil, err
+		}
+		return obj.Type(), nil
+	}
+
+	if u.attrValue, err = iface(attrPackagePath, "Value"); err != nil {
+		return nil, err
+	}
+	if u.expander, err = iface(flexPackagePath, "Expander"); err != nil {
+		return nil, err
+	}
+	if u.typedExpander, err = iface(flexPackagePath, "TypedExpander"); err != nil {
+		return nil, err
+	}
+	if u.flattener, err = iface(flexPackagePath, "Flattener"); err != nil {
+		return nil, err
+	}
+	for _, name := range []string{"BoolValuable", "Float64Valuable", "Float32Valuable", "Int64Valuable", "Int32Valuable", "StringValuable", "ObjectValuable", "ListValuable", "MapValuable", "SetValuable"} {
+		v, err := iface(basetypesPackagePath, name)
+		if err != nil {
+			return nil, err
+		}
+		u.valuables = append(u.valuables, v)
+	}
+	for name, ptr := range map[string]**types.TypeName{
+		"ListNestedObjectValueOf": &u.listNestedObjectValueOf,
+		"SetNestedObjectValueOf":  &u.setNestedObjectValueOf,
+	} {
+		obj, err := lookup(fwtypesPackagePath, name)
+		if err != nil {
+			return nil, err
+		}
+		*ptr = obj.(*types.TypeName)
+	}
+	for name, ptr := range map[string]*types.Type{
+		"BoolValue":    &u.boolValue,
+		"Float64Value": &u.float64Value,
+		"Int64Value":   &u.int64Value,
+		"StringValue":  &u.stringValue,
+	} {
+		if *ptr, err = typ(basetypesPackagePath, name); err != nil {
+			return nil, err
+		}
+	}
+
+	return u, nil
+}
+
+// annotations returns the conversions annotated on the service package's types.
+// The annotations are implemented as comments on type declarations, e.g.
+//
+//	// @AutoFlexExpand("bcmdataexports.CreateExportInput")
+//	// @AutoFlexFlatten("bcmdataexports.GetExportOutput", fieldNamePrefix="Export")
+//	type resourceExportData struct {
+func (u *universe) annotations() ([]*root, error) {
+	var roots []*root
+
+	for _, file := range u.pkg.Syntax {
+		for _, decl := range file.Decls {
+			decl, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				spec, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+				doc := spec.Doc
+				if doc == nil && len(decl.Specs) == 1 {
+					doc = decl.Doc
+				}
+				if doc == nil {
+					continue
+				}
+
+				for _, line := range doc.List {
+					m := annotation.FindStringSubmatch(line.Text)
+					if len(m) == 0 {
+						continue
+					}
+
+					var d direction
+					switch m[1] {
+					case "AutoFlexExpand":
+						d = expand
+					case "AutoFlexFlatten":
+						d = flatten
+					default:
+						continue
+					}
+
+					args := common.ParseArgs(m[3])
+					if len(args.Positional) != 1 {
+						return nil, fmt.Errorf("%s: @%s: want 1 type argument, got %d", spec.Name.Name, m[1], len(args.Positional))
+					}
+
+					tv, err := types.Eval(u.pkg.Fset, u.pkg.Types, spec.Pos(), args.Positional[0])
+					if err != nil {
+						return nil, fmt.Errorf("%s: @%s: %w", spec.Name.Name, m[1], err)
+					}
+					if !tv.IsType() {
+						return nil, fmt.Errorf("%s: @%s: %s is not a type", spec.Name.Name, m[1], args.Positional[0])
+					}
+					apiType, ok := namedStruct(tv.Type)
+					if !ok {
+						return nil, fmt.Errorf("%s: @%s: %s is not a named struct type", spec.Name.Name, m[1], args.Positional[0])
+					}
+					tfType, ok := namedStruct(u.pkg.TypesInfo.Defs[spec.Name].Type())
+					if !ok {
+						return nil, fmt.Errorf("%s: @%s: not a named struct type", spec.Name.Name, m[1])
+					}
+
+					var opts options
+					if v, ok := args.Keyword["fieldNamePrefix"]; ok {
+						opts.fieldNamePrefix = v
+					}
+					if v, ok := args.Keyword["fieldNameSuffix"]; ok {
+						opts.fieldNameSuffix = v
+					}
+					if v, ok := args.Keyword["ignoredFieldNames"]; ok {
+						opts.ignoredFieldNames = strings.Split(v, ";")
+					}
+					if v, ok := args.Keyword["noIgnoredFieldNames"]; ok {
+						if opts.noIgnoredFieldNames, err = strconv.ParseBool(v); err != nil {
+							return nil, fmt.Errorf("%s: @%s: noIgnoredFieldNames: %w", spec.Name.Name, m[1], err)
+						}
+					}
+
+					r := &root{
+						pair: pair{
+							direction: d,
+							options:   opts,
+						},
+					}
+					if d == expand {
+						r.from, r.to = tfType, apiType
+					} else {
+						r.from, r.to = apiType, tfType
+					}
+					roots = append(roots, r)
+				}
+			}
+		}
+	}
+
+	return roots, nil
+}
+
+// firstValuable returns the first basetypes Valuable interface that `t` implements.
+func (u *universe) firstValuable(t types.Type) valuable {
+	for i, v := range u.valuables {
+		if types.Implements(t, v) {
+			return valuable(i + 1)
+		}
+	}
+	return valuableNone
+}
+
+// nestedObjectOf returns the type argument if `t` is a fwtypes.ListNestedObjectValueOf or fwtypes.SetNestedObjectValueOf.
+func (u *universe) nestedObjectOf(t types.Type) (*types.Named, string, bool) {
+	named, ok := t.(*types.Named)
+	if !ok || named.TypeArgs().Len() != 1 {
+		return nil, "", false
+	}
+
+	var kind string
+	switch named.Origin().Obj() {
+	case u.listNestedObjectValueOf:
+		kind = "List"
+	case u.setNestedObjectValueOf:
+		kind = "Set"
+	default:
+		return nil, "", false
+	}
+
+	m, ok := namedStruct(named.TypeArgs().At(0))
+	if !ok {
+		return nil, "", false
+	}
+
+	return m, kind, true
+}
+
+func namedStruct(t types.Type) (*types.Named, bool) {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return nil, false
+	}
+	if _, ok := named.Underlying().(*types.Struct); !ok {
+		return nil, false
+	}
+	return named, true
+}
+
+func basicKind(t types.Type) (types.BasicKind, bool) {
+	if t, ok := t.Underlying().(*types.Basic); ok {
+		return t.Kind(), true
+	}
+	return types.Invalid, false
+}
+
+type generator struct {
+	u *universe
+
+	queue     []pair
+	functions map[string]string // Pair key to function name.
+	options   map[string]options
+	names     map[string]bool
+
+	imports map[string]string // Package path to name.
+	aliases map[string]string // Package name to path.
+
+	buf bytes.Buffer
+}
+
+func newGenerator(u *universe) *generator {
+	g := &generator{
+		u:         u,
+		functions: make(map[string]string),
+		options:   make(map[string]options),
+		names:     make(map[string]bool),
+		imports:   make(map[string]string),
+		aliases:   make(map[string]string),
+	}
+	g.importAs("context", "context")
+	g.importAs("github.com/hashicorp/terraform-plugin-framework/diag", "diag")
+	return g
+}
+
+// enqueue returns the name of the function implementing the conversion, queueing it for generation if necessary.
+func (g *generator) enqueue(p pair) (string, error) {
+	key := p.key()
+	if name, ok := g.functions[key]; ok {
+		if !g.options[key].equal(p.options) {
+			return "", fmt.Errorf("%s %s to %s: conflicting options", p.direction, p.from, p.to)
+		}
+		return name, nil
+	}
+
+	base := fmt.Sprintf("autoFlex%s%sTo%s", p.direction, firstUpper(p.from.Obj().Name()), firstUpper(p.to.Obj().Name()))
+	name := base
+	for i := 2; g.names[name]; i++ {
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+	g.names[name] = true
+	g.functions[key] = name
+	g.options[key] = p.options
+	g.queue = append(g.queue, p)
+
+	return name, nil
+}
+
+func (g *generator) run() error {
+	for len(g.queue) > 0 {
+		p := g.queue[0]
+		g.queue = g.queue[1:]
+
+		if err := g.function(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *generator) importAs(path, name string) string {
+	if v, ok := g.imports[path]; ok {
+		return v
+	}
+	alias := name
+	for i := 2; g.aliases[alias] != ""; i++ {
+		alias = fmt.Sprintf("%s%d", name, i)
+	}
+	g.imports[path] = alias
+	g.aliases[alias] = path
+	return alias
+}
+
+func (g *generator) qualifier(pkg *types.Package) string {
+	switch path := pkg.Path(); {
+	case path == g.u.pkg.PkgPath:
+		return ""
+	case path == typesPackagePath:
+		return g.importAs(path, "types")
+	case path == fwtypesPackagePath:
+		return g.importAs(path, "fwtypes")
+	case path == flexPackagePath:
+		return g.importAs(path, "fwflex")
+	case strings.HasPrefix(path, "github.com/aws/aws-sdk-go-v2/service/") && strings.HasSuffix(path, "/types"):
+		return g.importAs(path, "awstypes")
+	default:
+		return g.importAs(path, pkg.Name())
+	}
+}
+
+func (g *generator) typeString(t types.Type) string {
+	return types.TypeString(t, g.qualifier)
+}
+
+func (g *generator) printf(format string, a ...any) {
+	fmt.Fprintf(&g.buf, format, a...)
+}
+
+// field is a matched pair of struct fields.
+type field struct {
+	from, to   *types.Var
+	legacy     bool
+	omitempty  bool
+	fromAccess string
+	toAccess   string
+}
+
+// fields returns the matched fields of the pair's structs, replicating flex's autoFlexConvertStruct.
+func (g *generator) fields(p pair) ([]field, error) {
+	var fields []field
+
+	fromStruct := p.from.Underlying().(*types.Struct)
+
+	for i := 0; i < fromStruct.NumFields(); i++ {
+		fromField := fromStruct.Field(i)
+		if !fromField.Exported() {
+			continue
+		}
+		fromNameOverride, fromOpts := parseTag(fromStruct.Tag(i))
+		fieldName := fromField.Name()
+		if p.options.isIgnoredField(fieldName) {
+			continue
+		}
+		if fromNameOverride == "-" {
+			continue
+		}
+		if fieldName == mapBlockKeyFieldName {
+			continue
+		}
+
+		toField, toTag, ok, err := g.findFieldFuzzy(p, fieldName, false, false)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		toNameOverride, toOpts := parseTag(toTag)
+		if toNameOverride == "-" {
+			continue
+		}
+		if toOpts.contains("noflatten") {
+			continue
+		}
+
+		fields = append(fields, field{
+			from:       fromField,
+			to:         toField,
+			legacy:     fromOpts.contains("legacy") || toOpts.contains("legacy"),
+			omitempty:  toOpts.contains("omitempty"),
+			fromAccess: "from." + fieldName,
+			toAccess:   "to." + toField.Name(),
+		})
+	}
+
+	return fields, nil
+}
+
+// findFieldFuzzy replicates flex's findFieldFuzzy.
+func (g *generator) findFieldFuzzy(p pair, fieldNameFrom string, prefixRecurse, suffixRecurse bool) (*types.Var, string, bool, error) {
+	toStruct := p.to.Underlying().(*types.Struct)
+
+	// first precedence is exact match (case sensitive)
+	if fieldTo, tag, ok, err := g.fieldByName(p.to, fieldNameFrom); err != nil || ok {
+		return fieldTo, tag, ok, err
+	}
+
+	// second precedence is exact match (case insensitive)
+	for i := 0; i < toStruct.NumFields(); i++ {
+		field := toStruct.Field(i)
+		if !field.Exported() {
+			continue
+		}
+		fieldNameTo := field.Name()
+		if p.options.isIgnoredField(fieldNameTo) {
+			continue
+		}
+		if strings.EqualFold(fieldNameFrom, fieldNameTo) && !g.fieldExists(p.from, fieldNameTo) {
+			if fieldTo, tag, ok, err := g.fieldByName(p.to, fieldNameTo); err != nil || ok {
+				return fieldTo, tag, ok, err
+			}
+		}
+	}
+
+	// third precedence is singular/plural
+	fieldNameTo := plural.Plural(fieldNameFrom)
+	if plural.IsSingular(fieldNameFrom) && !g.fieldExists(p.from, fieldNameTo) {
+		if fieldTo, tag, ok, err := g.fieldByName(p.to, fieldNameTo); err != nil || ok {
+			return fieldTo, tag, ok, err
+		}
+	}
+
+	fieldNameTo = plural.Singular(fieldNameFrom)
+	if plural.IsPlural(fieldNameFrom) && !g.fieldExists(p.from, fieldNameTo) {
+		if fieldTo, tag, ok, err := g.fieldByName(p.to, fieldNameTo); err != nil || ok {
+			return fieldTo, tag, ok, err
+		}
+	}
+
+	// fourth precedence is using field name prefix
+	if v := p.options.fieldNamePrefix; v != "" {
+		v = strings.ReplaceAll(v, " ", "")
+		if !prefixRecurse {
+			if strings.HasPrefix(fieldNameFrom, v) {
+				return g.findFieldFuzzy(p, strings.TrimPrefix(fieldNameFrom, v), true, suffixRecurse)
+			}
+			return g.findFieldFuzzy(p, v+fieldNameFrom, true, suffixRecurse)
+		}
+	}
+
+	// fifth precedence is using field name suffix
+	if v := p.options.fieldNameSuffix; v != "" {
+		v = strings.ReplaceAll(v, " ", "")
+		if !suffixRecurse {
+			if strings.HasSuffix(fieldNameFrom, v) {
+				return g.findFieldFuzzy(p, strings.TrimSuffix(fieldNameFrom, v), prefixRecurse, true)
+			}
+			return g.findFieldFuzzy(p, fieldNameFrom+v, prefixRecurse, true)
+		}
+	}
+
+	return nil, "", false, nil
+}
+
+// fieldByName returns the named struct field and its tag.
+func (g *generator) fieldByName(t *types.Named, name string) (*types.Var, string, bool, error) {
+	obj, index, _ := types.LookupFieldOrMethod(t, false, g.u.pkg.Types, name)
+	v, ok := obj.(*types.Var)
+	if !ok || !v.IsField() {
+		return nil, "", false, nil
+	}
+	if len(index) != 1 {
+		return nil, "", false, fmt.Errorf("%s.%s: promoted fields are not supported", t, name)
+	}
+	return v, t.Underlying().(*types.Struct).Tag(index[0]), true, nil
+}
+
+func (g *generator) fieldExists(t *types.Named, name string) bool {
+	_, _, ok, _ := g.fieldByName(t, name)
+	return ok
+}
+
+func (g *generator) function(p pair) error {
+	fields, err := g.fields(p)
+	if err != nil {
+		return err
+	}
+
+	name := g.functions[p.key()]
+	from, to := g.typeString(types.NewPointer(p.from)), g.typeString(types.NewPointer(p.to))
+
+	g.printf("func %s(ctx context.Context, from %s, to %s) diag.Diagnostics {\n", name, from, to)
+	g.printf("var diags diag.Diagnostics\n\n")
+
+	for _, f := range fields {
+		g.printf("// %s -> %s.\n", f.from.Name(), f.to.Name())
+
+		var err error
+		if p.direction == expand {
+			err = g.expandField(p, f)
+		} else {
+			err = g.flattenField(p, f)
+		}
+		if err != nil {
+			return err
+		}
+		g.printf("\n")
+	}
+
+	g.printf("return diags\n")
+	g.printf("}\n\n")
+
+	return nil
+}
+
+const checkDiags = `diags.Append(d...)
+if diags.HasError() {
+	return diags
+}
+`
+
+func (g *generator) fallback(p pair, f field) {
+	opts := p.options.funcs()
+	if opts != "" {
+		opts = ", " + opts
+	}
+	g.qualifier(types.NewPackage(flexPackagePath, "flex"))
+	if p.direction == expand {
+		g.printf("diags.Append(fwflex.ExpandField(ctx, &%s, &%s, %t%s)...)\n", f.fromAccess, f.toAccess, f.legacy, opts)
+	} else {
+		g.printf("diags.Append(fwflex.FlattenField(ctx, &%s, &%s, %t, %t%s)...)\n", f.fromAccess, f.toAccess, f.legacy, f.omitempty, opts)
+	}
+	g.printf("if diags.HasError() {\nreturn diags\n}\n")
+}
+
+// expandField generates code replicating flex's autoExpander.convert for the supported field types.
+// Unsupported field types fall back to flex.ExpandField.
+func (g *generator) expandField(p pair, f field) error {
+	tFrom, tTo := f.from.Type(), f.to.Type()
+	u := g.u
+
+	if !types.Implements(tFrom, u.attrValue) || types.Implements(tFrom, u.expander) || types.Implements(tFrom, u.typedExpander) {
+		g.fallback(p, f)
+		return nil
+	}
+
+	// Scalar target.
+	scalar := func(toValue, value string, valueKind types.BasicKind, kinds ...types.BasicKind) bool {
+		if kind, ok := basicKind(tTo); ok && slices.Contains(kinds, kind) {
+			g.printf("if !%[1]s.IsNull() && !%[1]s.IsUnknown() {\n", f.fromAccess)
+			g.printf("v, d := %s.%s(ctx)\n", f.fromAccess, toValue)
+			g.printf(checkDiags)
+			if types.Identical(tTo, types.Typ[valueKind]) {
+				g.printf("%s = %s\n", f.toAccess, value)
+			} else {
+				g.printf("%s = %s(%s)\n", f.toAccess, g.typeString(tTo), value)
+			}
+			g.printf("}\n")
+			return true
+		}
+
+		ptr, ok := tTo.(*types.Pointer)
+		if !ok {
+			return false
+		}
+		for _, kind := range kinds {
+			if !types.Identical(ptr.Elem(), types.Typ[kind]) {
+				continue
+			}
+			x := value
+			if kind != valueKind {
+				x = fmt.Sprintf("%s(%s)", types.Typ[kind].Name(), value)
+			}
+
+			g.printf("if !%[1]s.IsNull() && !%[1]s.IsUnknown() {\n", f.fromAccess)
+			g.printf("v, d := %s.%s(ctx)\n", f.fromAccess, toValue)
+			g.printf(checkDiags)
+			if f.legacy {
+				zero := "0"
+				switch kind {
+				case types.Bool:
+					zero = "false"
+				case types.String:
+					zero = `""`
+				}
+				g.printf("if x := %s; x != %s {\n", x, zero)
+				g.printf("%s = &x\n", f.toAccess)
+				g.printf("}\n")
+			} else {
+				g.printf("x := %s\n", x)
+				g.printf("%s = &x\n", f.toAccess)
+			}
+			g.printf("}\n")
+			return true
+		}
+		return false
+	}
+
+	switch u.firstValuable(tFrom) {
+	case valuableBool:
+		if scalar("ToBoolValue", "v.ValueBool()", types.Bool, types.Bool) {
+			return nil
+		}
+
+	case valuableFloat64:
+		if scalar("ToFloat64Value", "v.ValueFloat64()", types.Float64, types.Float32, types.Float64) {
+			return nil
+		}
+
+	case valuableInt64:
+		if scalar("ToInt64Value", "v.ValueInt64()", types.Int64, types.Int32, types.Int64) {
+			return nil
+		}
+
+	case valuableString:
+		if scalar("ToStringValue", "v.ValueString()", types.String, types.String) {
+			return nil
+		}
+
+	case valuableList, valuableSet:
+		m, _, ok := u.nestedObjectOf(tFrom)
+		if !ok || types.Implements(m, u.expander) || types.Implements(m, u.typedExpander) {
+			break
+		}
+
+		// types.List(OfObject) -> (*)struct.
+		isPtr := false
+		s, ok := namedStruct(tTo)
+		if !ok {
+			if ptr, ok := tTo.(*types.Pointer); ok {
+				s, isPtr = namedStruct(ptr.Elem())
+			}
+		}
+		if s != nil && !types.Implements(types.NewPointer(s), u.flattener) {
+			nested, err := g.enqueue(pair{direction: expand, from: m, to: s, options: p.options})
+			if err != nil {
+				return err
+			}
+
+			g.printf("if !%[1]s.IsNull() && !%[1]s.IsUnknown() {\n", f.fromAccess)
+			g.printf("ptr, d := %s.ToPtr(ctx)\n", f.fromAccess)
+			g.printf(checkDiags)
+			g.printf("x := new(%s)\n", g.typeString(s))
+			g.printf("if ptr != nil {\n")
+			g.printf("diags.Append(%s(ctx, ptr, x)...)\n", nested)
+			g.printf("if diags.HasError() {\nreturn diags\n}\n")
+			g.printf("}\n")
+			if isPtr {
+				g.printf("%s = x\n", f.toAccess)
+			} else {
+				g.printf("%s = *x\n", f.toAccess)
+			}
+			g.printf("}\n")
+			return nil
+		}
+
+		// types.List(OfObject) -> [](*)struct.
+		slice, ok := tTo.Underlying().(*types.Slice)
+		if !ok {
+			break
+		}
+		isPtr = false
+		s, ok = namedStruct(slice.Elem())
+		if !ok {
+			if ptr, ok := slice.Elem().(*types.Pointer); ok {
+				s, isPtr = namedStruct(ptr.Elem())
+			}
+		}
+		if s == nil || types.Implements(types.NewPointer(s), u.flattener) {
+			break
+		}
+		nested, err := g.enqueue(pair{direction: expand, from: m, to: s, options: p.options})
+		if err != nil {
+			return err
+		}
+
+		g.printf("if !%[1]s.IsNull() && !%[1]s.IsUnknown() {\n", f.fromAccess)
+		g.printf("s, d := %s.ToSlice(ctx)\n", f.fromAccess)
+		g.printf(checkDiags)
+		g.printf("x := make(%s, len(s))\n", g.typeString(tTo))
+		g.printf("for i, ptr := range s {\n")
+		if isPtr {
+			g.printf("x[i] = new(%s)\n", g.typeString(s))
+			g.printf("diags.Append(%s(ctx, ptr, x[i])...)\n", nested)
+		} else {
+			g.printf("diags.Append(%s(ctx, ptr, &x[i])...)\n", nested)
+		}
+		g.printf("if diags.HasError() {\nreturn diags\n}\n")
+		g.printf("}\n")
+		g.printf("%s = x\n", f.toAccess)
+		g.printf("}\n")
+		return nil
+	}
+
+	g.fallback(p, f)
+	return nil
+}
+
+// flattenField generates code replicating flex's autoFlattener.convert for the supported field types.
+// Unsupported field types fall back to flex.FlattenField.
+func (g *generator) flattenField(p pair, f field) error {
+	tFrom, tTo := f.from.Type(), f.to.Type()
+	u := g.u
+
+	// Scalar target.
+	isPtr := false
+	tElem := tFrom
+	if ptr, ok := tFrom.(*types.Pointer); ok {
+		isPtr, tElem = true, ptr.Elem()
+	}
+	if kind, ok := basicKind(tElem); ok {
+		var target types.Type
+		var constructor, null, zero, conversion string
+		switch kind {
+		case types.Bool:
+			target, constructor, null, zero, conversion = u.boolValue, "BoolValue", "BoolNull", "false", "bool"
+		case types.Float64:
+			target, constructor, null, zero, conversion = u.float64Value, "Float64Value", "Float64Null", "0", "float64"
+		case types.Int32, types.Int64:
+			target, constructor, null, zero, conversion = u.int64Value, "Int64Value", "Int64Null", "0", "int64"
+		case types.String:
+			target, constructor, null, zero, conversion = u.stringValue, "StringValue", "StringNull", `""`, "string"
+		}
+
+		if target != nil && types.Identical(tTo, target) {
+			g.qualifier(types.NewPackage(typesPackagePath, "types"))
+
+			value := f.fromAccess
+			if isPtr {
+				value = "*" + value
+			}
+			if !types.Identical(tElem, types.Typ[kind]) || kind == types.Int32 {
+				value = fmt.Sprintf("%s(%s)", conversion, value)
+			}
+
+			if isPtr {
+				g.printf("if %s == nil {\n", f.fromAccess)
+				if f.legacy {
+					g.printf("%s = types.%s(%s)\n", f.toAccess, constructor, zero)
+				} else {
+					g.printf("%s = types.%s()\n", f.toAccess, null)
+				}
+				g.printf("} else ")
+			}
+			if kind == types.String && f.omitempty && !f.legacy {
+				g.printf("if v := %s; v != \"\" {\n", value)
+				g.printf("%s = types.%s(v)\n", f.toAccess, constructor)
+				g.printf("} else {\n")
+				g.printf("%s = types.%s()\n", f.toAccess, null)
+				g.printf("}\n")
+			} else {
+				if isPtr {
+					g.printf("{\n")
+				}
+				g.printf("%s = types.%s(%s)\n", f.toAccess, constructor, value)
+				if isPtr {
+					g.printf("}\n")
+				}
+			}
+			return nil
+		}
+	}
+
+	// Nested object target.
+	if m, kind, ok := u.nestedObjectOf(tTo); ok && !types.Implements(types.NewPointer(m), u.flattener) {
+		isSlice, isPtrElem := false, false
+		s, ok := namedStruct(tElem)
+		if !ok {
+			if slice, ok := tFrom.Underlying().(*types.Slice); ok && !isPtr {
+				isSlice = true
+				s, ok = namedStruct(slice.Elem())
+				if !ok {
+					if ptr, ok := slice.Elem().(*types.Pointer); ok {
+						s, isPtrElem = namedStruct(ptr.Elem())
+					}
+				}
+			}
+		}
+
+		if s != nil {
+			nested, err := g.enqueue(pair{direction: flatten, from: s, to: m, options: p.options})
+			if err != nil {
+				return err
+			}
+			g.qualifier(types.NewPackage(fwtypesPackagePath, "fwtypes"))
+			mType := g.typeString(m)
+
+			if isSlice {
+				// [](*)struct -> types.List(OfObject).
+				g.printf("if %s == nil {\n", f.fromAccess)
+				if f.legacy {
+					g.printf("v, d := fwtypes.New%sNestedObjectValueOfSlice(ctx, []*%s{})\n", kind, mType)
+					g.printf(checkDiags)
+					g.printf("%s = v\n", f.toAccess)
+				} else {
+					g.printf("%s = fwtypes.New%sNestedObjectValueOfNull[%s](ctx)\n", f.toAccess, kind, mType)
+				}
+				g.printf("} else {\n")
+				g.printf("s := make([]*%s, len(%s))\n", mType, f.fromAccess)
+				g.printf("for i := range %s {\n", f.fromAccess)
+				g.printf("s[i] = new(%s)\n", mType)
+				if isPtrElem {
+					g.printf("diags.Append(%s(ctx, %s[i], s[i])...)\n", nested, f.fromAccess)
+				} else {
+					g.printf("diags.Append(%s(ctx, &%s[i], s[i])...)\n", nested, f.fromAccess)
+				}
+				g.printf("if diags.HasError() {\nreturn diags\n}\n")
+				g.printf("}\n")
+				g.printf("v, d := fwtypes.New%sNestedObjectValueOfSlice(ctx, s)\n", kind)
+				g.printf(checkDiags)
+				g.printf("%s = v\n", f.toAccess)
+				g.printf("}\n")
+				return nil
+			}
+
+			// (*)struct -> types.List(OfObject).
+			if isPtr {
+				g.printf("if %s == nil {\n", f.fromAccess)
+				if f.legacy {
+					g.printf("v, d := fwtypes.New%sNestedObjectValueOfPtr(ctx, new(%s))\n", kind, mType)
+					g.printf(checkDiags)
+					g.printf("%s = v\n", f.toAccess)
+				} else {
+					g.printf("%s = fwtypes.New%sNestedObjectValueOfNull[%s](ctx)\n", f.toAccess, kind, mType)
+				}
+				g.printf("} else {\n")
+			} else {
+				g.printf("{\n")
+			}
+			g.printf("x := new(%s)\n", mType)
+			if isPtr {
+				g.printf("diags.Append(%s(ctx, %s, x)...)\n", nested, f.fromAccess)
+			} else {
+				g.printf("diags.Append(%s(ctx, &%s, x)...)\n", nested, f.fromAccess)
+			}
+			g.printf("if diags.HasError() {\nreturn diags\n}\n")
+			g.printf("v, d := fwtypes.New%sNestedObjectValueOfPtr(ctx, x)\n", kind)
+			g.printf(checkDiags)
+			g.printf("%s = v\n", f.toAccess)
+			g.printf("}\n")
+			return nil
+		}
+	}
+
+	g.fallback(p, f)
+	return nil
+}
+
+// source returns the formatted Go source of the generated file.
+func (g *generator) source(servicePackage string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "// Code generated by internal/generate/autoflex/main.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", servicePackage)
+	writeImports(&buf, g.imports)
+	buf.Write(g.buf.Bytes())
+
+	return format.Source(buf.Bytes())
+}
+
+func testSource(servicePackage string, roots []*root) ([]byte, error) {
+	var buf, body bytes.Buffer
+
+	imports := map[string]string{
+		"testing":           "testing",
+		flextestPackagePath: "flextest",
+	}
+
+	names := make(map[string]bool)
+	for _, r := range roots {
+		if names[r.function] {
+			continue
+		}
+		names[r.function] = true
+
+		opts := r.options.funcs()
+		if opts != "" {
+			imports[flexPackagePath] = "fwflex"
+			opts = ", " + opts
+		}
+
+		fmt.Fprintf(&body, "func Test%s(t *testing.T) {\n", firstUpper(r.function))
+		fmt.Fprintf(&body, "t.Parallel()\n\n")
+		fmt.Fprintf(&body, "flextest.%sMatches(t, %s%s)\n", r.direction, r.function, opts)
+		fmt.Fprintf(&body, "}\n\n")
+	}
+
+	fmt.Fprintf(&buf, "// Code generated by internal/generate/autoflex/main.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", servicePackage)
+	writeImports(&buf, imports)
+	buf.Write(body.Bytes())
+
+	return format.Source(buf.Bytes())
+}
+
+// writeImports writes an import declaration, standard library packages first.
+func writeImports(buf *bytes.Buffer, imports map[string]string) {
+	var std, other []string
+	for path := range imports {
+		if strings.Contains(path, ".") {
+			other = append(other, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	slices.Sort(std)
+	slices.Sort(other)
+
+	spec := func(path string) string {
+		if name := imports[path]; name != filepath.Base(path) {
+			return fmt.Sprintf("%s %q", name, path)
+		}
+		return strconv.Quote(path)
+	}
+
+	fmt.Fprintf(buf, "import (\n")
+	for _, path := range std {
+		fmt.Fprintf(buf, "%s\n", spec(path))
+	}
+	if len(std) > 0 && len(other) > 0 {
+		fmt.Fprintf(buf, "\n")
+	}
+	for _, path := range other {
+		fmt.Fprintf(buf, "%s\n", spec(path))
+	}
+	fmt.Fprintf(buf, ")\n\n")
+}
+
+// tagOptions mirrors flex's tagOptions.
+type tagOptions string
+
+func parseTag(tag string) (string, tagOptions) {
+	name, opts, _ := strings.Cut(reflect.StructTag(tag).Get("autoflex"), ",")
+	return name, tagOptions(opts)
+}
+
+func (o tagOptions) contains(optionName string) bool {
+	return slices.Contains(strings.Split(string(o), ","), optionName)
+}
+
+func firstUpper(s string) string {
+	r, n := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[n:]
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"fmt"
+	"go/types"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	filename = `unions_gen.go`
+
+	unionMemberInfix          = "Member"
+	unionMemberValueFieldName = "Value"
+)
+
+type Union struct {
+	Name    string
+	Members []string
+}
+
+type TemplateData struct {
+	AWSService     string
+	ServicePackage string
+	Unions         []Union
+}
+
+func main() {
+	g := common.NewGenerator()
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating internal/service/%s/%s", servicePackage, filename)
+
+	service, err := data.LookupService(servicePackage)
+	if err != nil {
+		g.Fatalf("encountered: %s", err)
+	}
+
+	awsService := service.GoV2Package()
+
+	unions, err := loadUnions(fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%s/types", awsService))
+	if err != nil {
+		g.Fatalf("loading union types: %s", err)
+	}
+	if len(unions) == 0 {
+		g.Fatalf("no union types found for %s", awsService)
+	}
+
+	templateData := TemplateData{
+		AWSService:     awsService,
+		ServicePackage: servicePackage,
+		Unions:         unions,
+	}
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.WriteTemplate("unions", tmpl, templateData); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+// loadUnions returns the union types declared in the specified AWS SDK for Go v2 types package.
+// A union is an interface type, e.g. `KmsKey`, whose only method is the unexported marker method `isKmsKey()`.
+// Its members are the struct types named `<union>Member<name>` with a `Value` field whose pointer types implement the interface.
+func loadUnions(path string) ([]Union, error) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes,
+	}
+	pkgs, err := packages.Load(cfg, path)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 || pkgs[0].Types == nil {
+		return nil, fmt.Errorf("package %s not loaded", path)
+	}
+	if len(pkgs[0].Errors) > 0 {
+		return nil, pkgs[0].Errors[0]
+	}
+
+	scope := pkgs[0].Types.Scope()
+	names := scope.Names() // Sorted.
+
+	var unions []Union
+	for _, name := range names {
+		obj, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || !obj.Exported() {
+			continue
+		}
+		iface, ok := obj.Type().Underlying().(*types.Interface)
+		if !ok || iface.NumMethods() != 1 || iface.Method(0).Name() != "is"+name {
+			continue
+		}
+
+		union := Union{
+			Name: name,
+		}
+		for _, memberName := range names {
+			if v, ok := strings.CutPrefix(memberName, name+unionMemberInfix); !ok || v == "" {
+				continue
+			}
+			member, ok := scope.Lookup(memberName).(*types.TypeName)
+			if !ok {
+				continue
+			}
+			if _, ok := member.Type().Underlying().(*types.Struct); !ok {
+				continue
+			}
+			if obj, _, _ := types.LookupFieldOrMethod(member.Type(), false, member.Pkg(), unionMemberValueFieldName); obj == nil {
+				continue
+			}
+			if !types.Implements(types.NewPointer(member.Type()), iface) {
+				continue
+			}
+			union.Members = append(union.Members, memberName)
+		}
+
+		if len(union.Members) > 0 {
+			slices.Sort(union.Members)
+			unions = append(unions, union)
+		}
+	}
+
+	return unions, nil
+}
+
+//go:embed file.tmpl
+var tmpl string
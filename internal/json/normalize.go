@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"slices"
+	"strconv"
+)
+
+// A Normalizer rewrites a decoded JSON value into a canonical form.
+// Objects are decoded as map[string]any, arrays as []any and numbers as json.Number.
+type Normalizer func(any) any
+
+// Normalize returns the canonical form of the JSON document in the given string.
+// The document is decoded, each normalizer is applied in order and the result re-encoded with sorted object keys.
+func Normalize(s string, normalizers ...Normalizer) (string, error) {
+	var v any
+	d := json.NewDecoder(bytes.NewReader([]byte(s)))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return "", err
+	}
+
+	for _, f := range normalizers {
+		v = f(v)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// EquivalentStrings returns whether the JSON documents in the given strings are equal once normalized.
+func EquivalentStrings(s1, s2 string, normalizers ...Normalizer) bool {
+	n1, err := Normalize(s1, normalizers...)
+	if err != nil {
+		return false
+	}
+
+	n2, err := Normalize(s2, normalizers...)
+	if err != nil {
+		return false
+	}
+
+	return n1 == n2
+}
+
+// NormalizeNumbers rewrites numbers so that numerically equal values, e.g. `1`, `1.0` and `1e0`, are identical.
+func NormalizeNumbers(v any) any {
+	return walk(v, func(v any) any {
+		if n, ok := v.(json.Number); ok {
+			return normalizeNumber(n)
+		}
+		return v
+	})
+}
+
+func normalizeNumber(n json.Number) json.Number {
+	r, ok := new(big.Rat).SetString(n.String())
+	if !ok {
+		return n
+	}
+
+	if r.IsInt() {
+		return json.Number(r.Num().String())
+	}
+
+	f, _ := r.Float64()
+
+	return json.Number(strconv.FormatFloat(f, 'g', -1, 64))
+}
+
+// StripEmpty removes object members whose values are `null`, empty arrays (`[]`) or empty objects (`{}`).
+// Members that become empty once their own members are removed are also removed.
+func StripEmpty(v any) any {
+	return walk(v, func(v any) any {
+		if m, ok := v.(map[string]any); ok {
+			for k, v := range m {
+				if isEmpty(v) {
+					delete(m, k)
+				}
+			}
+		}
+		return v
+	})
+}
+
+func isEmpty(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+
+	return false
+}
+
+// StripFields returns a Normalizer that removes the named object members at any depth, e.g. read-only fields added by AWS.
+func StripFields(names ...string) Normalizer {
+	return func(v any) any {
+		return walk(v, func(v any) any {
+			if m, ok := v.(map[string]any); ok {
+				for _, name := range names {
+					delete(m, name)
+				}
+			}
+			return v
+		})
+	}
+}
+
+// StripDefaults returns a Normalizer that removes object members at any depth whose values are the defaults that AWS adds when the member is omitted.
+// defaults maps member names to their default values, e.g. `{"Essential": true}`.
+func StripDefaults(defaults map[string]any) Normalizer {
+	normalized := make(map[string]any, len(defaults))
+	for k, v := range defaults {
+		normalized[k] = NormalizeNumbers(decoded(v))
+	}
+
+	return func(v any) any {
+		return walk(v, func(v any) any {
+			if m, ok := v.(map[string]any); ok {
+				for k, def := range normalized {
+					if v, ok := m[k]; ok && reflect.DeepEqual(NormalizeNumbers(v), def) {
+						delete(m, k)
+					}
+				}
+			}
+			return v
+		})
+	}
+}
+
+// decoded returns the Go value as it would be decoded by Normalize.
+func decoded(v any) any {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+
+	var out any
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&out); err != nil {
+		return v
+	}
+
+	return out
+}
+
+// SortArraysByField returns a Normalizer that sorts, at any depth, the arrays whose elements are all objects with a string member of the given name.
+// The arrays are sorted by that member's value. This removes differences where AWS reorders unordered collections such as environment variables.
+func SortArraysByField(name string) Normalizer {
+	return func(v any) any {
+		return walk(v, func(v any) any {
+			a, ok := v.([]any)
+			if !ok || len(a) == 0 {
+				return v
+			}
+
+			for _, e := range a {
+				m, ok := e.(map[string]any)
+				if !ok {
+					return v
+				}
+				if _, ok := m[name].(string); !ok {
+					return v
+				}
+			}
+
+			slices.SortStableFunc(a, func(x, y any) int {
+				return cmp.Compare(x.(map[string]any)[name].(string), y.(map[string]any)[name].(string))
+			})
+
+			return a
+		})
+	}
+}
+
+// walk applies f to every value in the document, children before their parents.
+func walk(v any, f func(any) any) any {
+	switch v := v.(type) {
+	case []any:
+		for i, e := range v {
+			v[i] = walk(e, f)
+		}
+	case map[string]any:
+		for k, e := range v {
+			v[k] = walk(e, f)
+		}
+	}
+
+	return f(v)
+}
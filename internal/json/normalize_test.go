@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName    string
+		input       string
+		normalizers []json.Normalizer
+		want        string
+		wantErr     bool
+	}{
+		{
+			testName: "invalid JSON",
+			input:    `{"a":`,
+			wantErr:  true,
+		},
+		{
+			testName: "key ordering",
+			input:    `{ "b": 1, "a": { "d": 2.50, "c": [] } }`,
+			want:     `{"a":{"c":[],"d":2.50},"b":1}`,
+		},
+		{
+			testName:    "numbers",
+			input:       `{"a": 1.0, "b": 1e3, "c": 2.50, "d": [-0.0, 12345678901234567890]}`,
+			normalizers: []json.Normalizer{json.NormalizeNumbers},
+			want:        `{"a":1,"b":1000,"c":2.5,"d":[0,12345678901234567890]}`,
+		},
+		{
+			testName:    "empty",
+			input:       `{"a": null, "b": [], "c": {"d": {}}, "e": [null, {}], "f": ""}`,
+			normalizers: []json.Normalizer{json.StripEmpty},
+			want:        `{"e":[null,{}],"f":""}`,
+		},
+		{
+			testName:    "fields",
+			input:       `{"a": 1, "b": {"a": 2, "c": 3}}`,
+			normalizers: []json.Normalizer{json.StripFields("a")},
+			want:        `{"b":{"c":3}}`,
+		},
+		{
+			testName:    "defaults",
+			input:       `{"essential": true, "interval": 30.0, "retries": 2, "nested": [{"essential": false}]}`,
+			normalizers: []json.Normalizer{json.StripDefaults(map[string]any{"essential": true, "interval": 30, "retries": 3})},
+			want:        `{"nested":[{"essential":false}],"retries":2}`,
+		},
+		{
+			testName:    "defaults then empty",
+			input:       `{"healthCheck": {"retries": 3}}`,
+			normalizers: []json.Normalizer{json.StripDefaults(map[string]any{"retries": 3}), json.StripEmpty},
+			want:        `{}`,
+		},
+		{
+			testName:    "sort arrays",
+			input:       `{"environment": [{"name": "b", "value": "1"}, {"name": "a", "value": "2"}], "other": [{"name": "b"}, {"value": "a"}], "strings": ["b", "a"]}`,
+			normalizers: []json.Normalizer{json.SortArraysByField("name")},
+			want:        `{"environment":[{"name":"a","value":"2"},{"name":"b","value":"1"}],"other":[{"name":"b"},{"value":"a"}],"strings":["b","a"]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := json.Normalize(testCase.input, testCase.normalizers...)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("Normalize(%q) err %t, want %t", testCase.input, got, want)
+			}
+			if got, want := got, testCase.want; got != want {
+				t.Errorf("Normalize(%q) = %q, want %q", testCase.input, got, want)
+			}
+		})
+	}
+}
+
+func TestEquivalentStrings(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName    string
+		s1, s2      string
+		normalizers []json.Normalizer
+		want        bool
+	}{
+		{
+			testName: "invalid JSON",
+			s1:       `{}`,
+			s2:       `{`,
+		},
+		{
+			testName: "whitespace and key ordering",
+			s1:       `{"a": 1, "b": 2}`,
+			s2:       `{ "b":2,"a":1 }`,
+			want:     true,
+		},
+		{
+			testName: "numbers not normalized",
+			s1:       `{"a": 1}`,
+			s2:       `{"a": 1.0}`,
+		},
+		{
+			testName:    "numbers normalized",
+			s1:          `{"a": 1}`,
+			s2:          `{"a": 1.0}`,
+			normalizers: []json.Normalizer{json.NormalizeNumbers},
+			want:        true,
+		},
+		{
+			testName:    "AWS-added defaults",
+			s1:          `{"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}}}`,
+			s2:          `{"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true, "Parameters": {}}}, "QueryLanguage": "JSONPath"}`,
+			normalizers: []json.Normalizer{json.StripDefaults(map[string]any{"QueryLanguage": "JSONPath"}), json.StripEmpty},
+			want:        true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := json.EquivalentStrings(testCase.s1, testCase.s2, testCase.normalizers...), testCase.want; got != want {
+				t.Errorf("EquivalentStrings(%q, %q) = %t, want %t", testCase.s1, testCase.s2, got, want)
+			}
+		})
+	}
+}
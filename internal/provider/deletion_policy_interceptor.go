@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// newDeletionPolicyInterceptor returns an interceptor implementing the provider's `deletion_policy` for a resource with the specified schema.
+func newDeletionPolicyInterceptor(s map[string]*schema.Schema) deletionPolicyInterceptor {
+	var interceptor deletionPolicyInterceptor
+
+	if v, ok := s[names.AttrARN]; ok && v.Type == schema.TypeString {
+		interceptor.arnAttribute = names.AttrARN
+	}
+	// Prefer `tags_all`, which includes any default tags.
+	for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+		if v, ok := s[k]; ok && v.Type == schema.TypeMap {
+			interceptor.tagsAttribute = k
+			break
+		}
+	}
+
+	return interceptor
+}
+
+// deletionPolicyInterceptor implements the provider's `deletion_policy`.
+// Deletion of a resource matching the policy fails before the resource's Delete handler is called.
+type deletionPolicyInterceptor struct {
+	arnAttribute  string
+	tagsAttribute string
+}
+
+func (r deletionPolicyInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok || c.DeletionPolicy == nil {
+		return ctx, diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		switch why {
+		case Delete:
+			var arn string
+			if r.arnAttribute != "" {
+				arn, _ = d.Get(r.arnAttribute).(string)
+			}
+			var tags map[string]string
+			if r.tagsAttribute != "" {
+				if v, ok := d.Get(r.tagsAttribute).(map[string]any); ok {
+					tags = flex.ExpandStringValueMap(v)
+				}
+			}
+
+			if reason, denied := c.DeletionPolicy.Denies(inContext.TypeName, arn, tags); denied {
+				return ctx, sdkdiag.AppendErrorf(diags, "deleting %s (%s): denied by provider deletion_policy: %s", inContext.TypeName, d.Id(), reason)
+			}
+		}
+	}
+
+	return ctx, diags
+}
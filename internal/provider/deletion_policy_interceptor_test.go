@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestExpandDeletionPolicy(t *testing.T) {
+	t.Parallel()
+
+	if got := expandDeletionPolicy(nil); got != nil {
+		t.Errorf("expandDeletionPolicy(nil) = %v, want nil", got)
+	}
+
+	got := expandDeletionPolicy(map[string]interface{}{
+		"arns":           schema.NewSet(schema.HashString, []interface{}{"arn:aws:s3:::example"}), //lintignore:AWSAT005
+		"resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_db_*"}),
+		"tags":           map[string]interface{}{"Protected": "*"},
+	})
+	if reason, denied := got.Denies("aws_db_instance", "", nil); !denied {
+		t.Errorf("expected deletion to be denied")
+	} else if reason == "" {
+		t.Errorf("expected reason, got none")
+	}
+}
+
+func TestDeletionPolicyInterceptor(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := map[string]*schema.Schema{
+		names.AttrARN: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		names.AttrTags: tftags.TagsSchema(),
+		names.AttrTagsAll: {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+
+	testCases := map[string]struct {
+		policy   *conns.DeletionPolicy
+		typeName string
+		why      why
+		arn      string
+		tagsAll  map[string]any
+		expected bool
+	}{
+		"no policy": {
+			typeName: "aws_test",
+			why:      Delete,
+		},
+		"resource type": {
+			policy: &conns.DeletionPolicy{
+				ResourceTypes: []string{"aws_t*"},
+			},
+			typeName: "aws_test",
+			why:      Delete,
+			expected: true,
+		},
+		"resource type not matched": {
+			policy: &conns.DeletionPolicy{
+				ResourceTypes: []string{"aws_db_*"},
+			},
+			typeName: "aws_test",
+			why:      Delete,
+		},
+		"ARN": {
+			policy: &conns.DeletionPolicy{
+				ARNs: []string{"arn:aws:test:us-west-2:123456789012:test/example"}, //lintignore:AWSAT003,AWSAT005
+			},
+			typeName: "aws_test",
+			why:      Delete,
+			arn:      "arn:aws:test:us-west-2:123456789012:test/example", //lintignore:AWSAT003,AWSAT005
+			expected: true,
+		},
+		"default tag": {
+			policy: &conns.DeletionPolicy{
+				Tags: map[string]string{"Environment": "production"},
+			},
+			typeName: "aws_test",
+			why:      Delete,
+			tagsAll:  map[string]any{"Environment": "production", "Name": "example"},
+			expected: true,
+		},
+		"not Delete": {
+			policy: &conns.DeletionPolicy{
+				ResourceTypes: []string{"*"},
+			},
+			typeName: "aws_test",
+			why:      Update,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, resourceSchema, map[string]any{})
+			d.SetId("example")
+			if err := d.Set(names.AttrARN, testCase.arn); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if err := d.Set(names.AttrTagsAll, testCase.tagsAll); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			meta := &conns.AWSClient{
+				DeletionPolicy: testCase.policy,
+			}
+			ctx := conns.NewResourceContext(context.Background(), "Test", "Test", testCase.typeName)
+
+			var diags diag.Diagnostics
+			_, diags = newDeletionPolicyInterceptor(resourceSchema).run(ctx, d, meta, Before, testCase.why, diags)
+
+			if got, want := diags.HasError(), testCase.expected; got != want {
+				t.Errorf("HasError = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestDeletionPolicyInterceptedHandler(t *testing.T) {
+	t.Parallel()
+
+	interceptors := interceptorItems{
+		{
+			when:        Before,
+			why:         Delete,
+			interceptor: newDeletionPolicyInterceptor(nil),
+		},
+	}
+
+	var called bool
+	var deleteFunc schema.DeleteContextFunc = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		called = true
+		return nil
+	}
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		return conns.NewResourceContext(ctx, "Test", "Test", "aws_test")
+	}
+	meta := &conns.AWSClient{
+		DeletionPolicy: &conns.DeletionPolicy{
+			ResourceTypes: []string{"aws_test"},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]any{})
+	d.SetId("example")
+	diags := interceptedHandler(bootstrapContext, interceptors, deleteFunc, Delete)(context.Background(), d, meta)
+
+	if !diags.HasError() {
+		t.Error("expected error, got none")
+	}
+	if called {
+		t.Error("Delete handler called")
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// deletionPolicyInterceptor implements the provider's `deletion_policy`.
+// Deletion of a resource matching the policy fails before the resource's Delete method is called.
+type deletionPolicyInterceptor struct{}
+
+func (r deletionPolicyInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r deletionPolicyInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r deletionPolicyInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r deletionPolicyInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil || meta.DeletionPolicy == nil {
+		return ctx, diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		var attributes map[string]tftypes.Value
+		if v := request.State.Raw; v.Type() != nil && v.IsKnown() && !v.IsNull() {
+			if err := v.As(&attributes); err != nil {
+				attributes = nil
+			}
+		}
+
+		arn := stringFromValue(attributes[names.AttrARN])
+		// Prefer `tags_all`, which includes any default tags.
+		tags := tagsFromValue(attributes[names.AttrTagsAll])
+		if tags == nil {
+			tags = tagsFromValue(attributes[names.AttrTags])
+		}
+
+		if reason, denied := meta.DeletionPolicy.Denies(inContext.TypeName, arn, tags); denied {
+			diags.AddError(
+				fmt.Sprintf("deleting %s", inContext.TypeName),
+				fmt.Sprintf("denied by provider deletion_policy: %s", reason),
+			)
+		}
+	}
+
+	return ctx, diags
+}
+
+// tagsFromValue returns the tags in a map of strings value.
+// nil is returned if the value is not a known, non-null map of strings.
+func tagsFromValue(v tftypes.Value) map[string]string {
+	if v.Type() == nil || !v.Type().Is(tftypes.Map{ElementType: tftypes.String}) || !v.IsKnown() || v.IsNull() {
+		return nil
+	}
+
+	var elements map[string]tftypes.Value
+	if err := v.As(&elements); err != nil {
+		return nil
+	}
+
+	tags := make(map[string]string, len(elements))
+	for k, v := range elements {
+		tags[k] = stringFromValue(v)
+	}
+
+	return tags
+}
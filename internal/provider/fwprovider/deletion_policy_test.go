@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDeletionPolicyInterceptor(t *testing.T) {
+	t.Parallel()
+
+	stateType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			names.AttrARN:     tftypes.String,
+			names.AttrID:      tftypes.String,
+			names.AttrTags:    tftypes.Map{ElementType: tftypes.String},
+			names.AttrTagsAll: tftypes.Map{ElementType: tftypes.String},
+		},
+	}
+	state := func(arn string, tags, tagsAll map[string]string) tftypes.Value {
+		mapValue := func(m map[string]string) tftypes.Value {
+			if m == nil {
+				return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil)
+			}
+			elements := make(map[string]tftypes.Value, len(m))
+			for k, v := range m {
+				elements[k] = tftypes.NewValue(tftypes.String, v)
+			}
+			return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, elements)
+		}
+
+		return tftypes.NewValue(stateType, map[string]tftypes.Value{
+			names.AttrARN:     tftypes.NewValue(tftypes.String, arn),
+			names.AttrID:      tftypes.NewValue(tftypes.String, "example"),
+			names.AttrTags:    mapValue(tags),
+			names.AttrTagsAll: mapValue(tagsAll),
+		})
+	}
+
+	testCases := map[string]struct {
+		policy   *conns.DeletionPolicy
+		when     when
+		state    tftypes.Value
+		expected bool
+	}{
+		"no policy": {
+			when:  Before,
+			state: state("", nil, nil),
+		},
+		"resource type": {
+			policy: &conns.DeletionPolicy{
+				ResourceTypes: []string{"aws_te?t"},
+			},
+			when:     Before,
+			state:    state("", nil, nil),
+			expected: true,
+		},
+		"ARN": {
+			policy: &conns.DeletionPolicy{
+				ARNs: []string{"arn:aws:test:us-west-2:123456789012:test/example"}, //lintignore:AWSAT003,AWSAT005
+			},
+			when:     Before,
+			state:    state("arn:aws:test:us-west-2:123456789012:test/example", nil, nil), //lintignore:AWSAT003,AWSAT005
+			expected: true,
+		},
+		"ARN not matched": {
+			policy: &conns.DeletionPolicy{
+				ARNs: []string{"arn:aws:test:us-west-2:123456789012:test/other"}, //lintignore:AWSAT003,AWSAT005
+			},
+			when:  Before,
+			state: state("arn:aws:test:us-west-2:123456789012:test/example", nil, nil), //lintignore:AWSAT003,AWSAT005
+		},
+		"default tag": {
+			policy: &conns.DeletionPolicy{
+				Tags: map[string]string{"Protected": "*"},
+			},
+			when:     Before,
+			state:    state("", map[string]string{"Name": "example"}, map[string]string{"Name": "example", "Protected": "true"}),
+			expected: true,
+		},
+		"tag value not matched": {
+			policy: &conns.DeletionPolicy{
+				Tags: map[string]string{"Environment": "production"},
+			},
+			when:  Before,
+			state: state("", nil, map[string]string{"Environment": "test"}),
+		},
+		"After": {
+			policy: &conns.DeletionPolicy{
+				ResourceTypes: []string{"*"},
+			},
+			when:  After,
+			state: state("", nil, nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			meta := &conns.AWSClient{
+				DeletionPolicy: testCase.policy,
+			}
+			ctx := conns.NewResourceContext(context.Background(), "Test", "Test", "aws_test")
+			request := resource.DeleteRequest{
+				State: tfsdk.State{
+					Raw: testCase.state,
+				},
+			}
+
+			var diags diag.Diagnostics
+			_, diags = deletionPolicyInterceptor{}.delete(ctx, request, &resource.DeleteResponse{}, meta, testCase.when, diags)
+
+			if got, want := diags.HasError(), testCase.expected; got != want {
+				t.Errorf("HasError = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestDeletionPolicyInterceptedResourceHandler(t *testing.T) {
+	t.Parallel()
+
+	interceptors := resourceInterceptors{deletionPolicyInterceptor{}}
+	meta := &conns.AWSClient{
+		DeletionPolicy: &conns.DeletionPolicy{
+			ResourceTypes: []string{"aws_test"},
+		},
+	}
+
+	var called bool
+	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
+		called = true
+		return response.Diagnostics
+	}
+	ctx := conns.NewResourceContext(context.Background(), "Test", "Test", "aws_test")
+
+	diags := interceptedResourceHandler(interceptors.delete(), f, meta)(ctx, resource.DeleteRequest{}, &resource.DeleteResponse{})
+
+	if !diags.HasError() {
+		t.Error("expected error, got none")
+	}
+	if called {
+		t.Error("Delete method called")
+	}
+}
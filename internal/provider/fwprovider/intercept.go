@@ -199,13 +199,16 @@ type wrappedDataSource struct {
 	inner            datasource.DataSourceWithConfigure
 	interceptors     dataSourceInterceptors
 	meta             *conns.AWSClient
+	// regionAttribute is true if the provider injects the `region` argument into the data source's schema.
+	regionAttribute bool
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors, regionAttribute bool) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		regionAttribute:  regionAttribute,
 	}
 }
 
@@ -217,14 +220,29 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regionAttribute {
+		response.Schema = dataSourceSchemaWithRegion(response.Schema)
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	f := func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		if w.regionAttribute {
+			w.readWithoutRegion(ctx, request, response)
+		} else {
+			w.inner.Read(ctx, request, response)
+		}
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	conns.SetTerraformOperation(ctx, "Read")
+	if w.regionAttribute {
+		if diags := setOverrideRegion(ctx, w.meta, regionFromValue(request.Config.Raw)); diags.HasError() {
+			response.Diagnostics.Append(diags...)
+			return
+		}
+	}
 	diags := interceptedDataSourceReadHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -254,13 +272,16 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	// regionAttribute is true if the provider injects the `region` argument into the resource's schema.
+	regionAttribute bool
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, regionAttribute bool) resource.ResourceWithConfigure {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		regionAttribute:  regionAttribute,
 	}
 }
 
@@ -272,44 +293,92 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regionAttribute {
+		response.Schema = resourceSchemaWithRegion(response.Schema)
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
-		w.inner.Create(ctx, request, response)
+		if w.regionAttribute {
+			w.createWithoutRegion(ctx, request, response)
+		} else {
+			w.inner.Create(ctx, request, response)
+		}
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	conns.SetTerraformOperation(ctx, "Create")
+	if w.regionAttribute {
+		if diags := setOverrideRegion(ctx, w.meta, regionFromValue(request.Plan.Raw)); diags.HasError() {
+			response.Diagnostics.Append(diags...)
+			return
+		}
+	}
 	diags := interceptedResourceHandler(w.interceptors.create(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		if w.regionAttribute {
+			w.readWithoutRegion(ctx, request, response)
+		} else {
+			w.inner.Read(ctx, request, response)
+		}
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	conns.SetTerraformOperation(ctx, "Read")
+	if w.regionAttribute {
+		if diags := setOverrideRegion(ctx, w.meta, regionFromValue(request.State.Raw)); diags.HasError() {
+			response.Diagnostics.Append(diags...)
+			return
+		}
+	}
 	diags := interceptedResourceHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
-		w.inner.Update(ctx, request, response)
+		if w.regionAttribute {
+			w.updateWithoutRegion(ctx, request, response)
+		} else {
+			w.inner.Update(ctx, request, response)
+		}
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	conns.SetTerraformOperation(ctx, "Update")
+	if w.regionAttribute {
+		if diags := setOverrideRegion(ctx, w.meta, regionFromValue(request.Plan.Raw)); diags.HasError() {
+			response.Diagnostics.Append(diags...)
+			return
+		}
+	}
 	diags := interceptedResourceHandler(w.interceptors.update(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
-		w.inner.Delete(ctx, request, response)
+		if w.regionAttribute {
+			w.deleteWithoutRegion(ctx, request, response)
+		} else {
+			w.inner.Delete(ctx, request, response)
+		}
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	conns.SetTerraformOperation(ctx, "Delete")
+	if w.regionAttribute {
+		if diags := setOverrideRegion(ctx, w.meta, regionFromValue(request.State.Raw)); diags.HasError() {
+			response.Diagnostics.Append(diags...)
+			return
+		}
+	}
 	diags := interceptedResourceHandler(w.interceptors.delete(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -325,7 +394,12 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ImportState(ctx, request, response)
+		conns.SetTerraformOperation(ctx, "ImportState")
+		if w.regionAttribute {
+			w.importStateWithoutRegion(ctx, v, request, response)
+		} else {
+			v.ImportState(ctx, request, response)
+		}
 
 		return
 	}
@@ -337,9 +411,27 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	conns.SetTerraformOperation(ctx, "ModifyPlan")
+
+	// Any per-resource Region override is validated at plan time, whether or not the inner resource modifies the plan.
+	if w.regionAttribute {
+		region := regionFromValue(request.Plan.Raw)
+		if region == "" {
+			region = regionFromValue(request.State.Raw)
+		}
+		if diags := setOverrideRegion(ctx, w.meta, region); diags.HasError() {
+			response.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ModifyPlan(ctx, request, response)
+		if w.regionAttribute {
+			w.modifyPlanWithoutRegion(ctx, v, request, response)
+		} else {
+			v.ModifyPlan(ctx, request, response)
+		}
 	}
 }
 
@@ -355,13 +447,20 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ValidateConfig(ctx, request, response)
+		if w.regionAttribute {
+			w.validateConfigWithoutRegion(ctx, v, request, response)
+		} else {
+			v.ValidateConfig(ctx, request, response)
+		}
 	}
 }
 
 func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.regionAttribute {
+			return w.stateUpgradersWithRegion(ctx, v.UpgradeState(ctx))
+		}
 
 		return v.UpgradeState(ctx)
 	}
@@ -372,6 +471,9 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
 	if v, ok := w.inner.(resource.ResourceWithMoveState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.regionAttribute {
+			return w.stateMoversWithRegion(ctx, v.MoveState(ctx))
+		}
 
 		return v.MoveState(ctx)
 	}
@@ -0,0 +1,558 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The per-resource `region` argument is injected into the schema of every data source and resource that doesn't already define a `region` attribute.
+// The inner data source or resource knows nothing of the argument, so it's removed from all values passed to the inner implementation
+// and added back to all values returned from it.
+
+const (
+	// importIDRegionSeparator separates a resource's import ID from any per-resource Region override.
+	importIDRegionSeparator = "@"
+)
+
+// dataSourceRegionAttribute returns the `region` argument injected into data sources.
+func dataSourceRegionAttribute() dsschema.Attribute {
+	return dsschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+		Description: "The AWS Region in which the data source's API calls are made. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// resourceRegionAttribute returns the `region` argument injected into resources.
+func resourceRegionAttribute() schema.Attribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+		Description: "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// dataSourceSchemaWithRegion returns a copy of the specified data source schema with the `region` argument added.
+func dataSourceSchemaWithRegion(s dsschema.Schema) dsschema.Schema {
+	s.Attributes = maps.Clone(s.Attributes)
+	if s.Attributes == nil {
+		s.Attributes = make(map[string]dsschema.Attribute)
+	}
+	s.Attributes[names.AttrRegion] = dataSourceRegionAttribute()
+
+	return s
+}
+
+// resourceSchemaWithRegion returns a copy of the specified resource schema with the `region` argument added.
+func resourceSchemaWithRegion(s schema.Schema) schema.Schema {
+	s.Attributes = maps.Clone(s.Attributes)
+	if s.Attributes == nil {
+		s.Attributes = make(map[string]schema.Attribute)
+	}
+	s.Attributes[names.AttrRegion] = resourceRegionAttribute()
+
+	return s
+}
+
+// withoutRegion returns the specified object value with the `region` attribute removed, along with the removed value.
+func withoutRegion(v tftypes.Value, typ tftypes.Type) (tftypes.Value, tftypes.Value, error) {
+	region := tftypes.NewValue(tftypes.String, nil)
+
+	if v.Type() == nil {
+		return v, region, nil
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nil
+	}
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), region, nil
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return v, region, err
+	}
+
+	if v, ok := attributes[names.AttrRegion]; ok {
+		region = v
+		delete(attributes, names.AttrRegion)
+	}
+
+	return tftypes.NewValue(typ, attributes), region, nil
+}
+
+// withRegion returns the specified object value with the `region` attribute added.
+func withRegion(v tftypes.Value, typ tftypes.Type, region tftypes.Value) (tftypes.Value, error) {
+	if v.Type() == nil {
+		return v, nil
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return v, err
+	}
+
+	attributes[names.AttrRegion] = region
+
+	return tftypes.NewValue(typ, attributes), nil
+}
+
+// stringFromValue returns the Go string value of a string attribute value.
+// Null and unknown values return an empty string.
+func stringFromValue(v tftypes.Value) string {
+	var s string
+	if v.IsKnown() && !v.IsNull() {
+		if err := v.As(&s); err != nil {
+			return ""
+		}
+	}
+
+	return s
+}
+
+// setOverrideRegion records any per-resource Region override in Context.
+// An error diagnostic is returned if the override is not in the provider's configured partition.
+func setOverrideRegion(ctx context.Context, meta *conns.AWSClient, region string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	// Clear any existing override so that the provider's configured Region is compared.
+	inContext.OverrideRegion = ""
+	if meta != nil {
+		if region == meta.Region(ctx) {
+			region = ""
+		}
+		if err := meta.ValidateOverrideRegion(ctx, region); err != nil {
+			diags.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region", err.Error())
+			return diags
+		}
+	}
+	inContext.OverrideRegion = region
+
+	return diags
+}
+
+// resolvedRegion returns the `region` attribute value to be persisted in state.
+// Null and unknown values are resolved to the Region used for API calls.
+func resolvedRegion(ctx context.Context, meta *conns.AWSClient, v tftypes.Value) tftypes.Value {
+	if v.IsKnown() && !v.IsNull() {
+		return v
+	}
+
+	if meta == nil {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	return tftypes.NewValue(tftypes.String, meta.Region(ctx))
+}
+
+// regionFromImportID splits any per-resource Region override from the specified import ID.
+func regionFromImportID(id string) (string, string) {
+	if i := strings.LastIndex(id, importIDRegionSeparator); i > 0 {
+		if region := id[i+len(importIDRegionSeparator):]; itypes.IsAWSRegion(region) {
+			return id[:i], region
+		}
+	}
+
+	return id, ""
+}
+
+// regionFromRawState returns any `region` attribute value from a resource's raw JSON state.
+func regionFromRawState(rawState *tfprotov6.RawState) tftypes.Value {
+	if rawState != nil && len(rawState.JSON) > 0 {
+		var attributes map[string]json.RawMessage
+		if err := json.Unmarshal(rawState.JSON, &attributes); err == nil {
+			var region string
+			if err := json.Unmarshal(attributes[names.AttrRegion], &region); err == nil && region != "" {
+				return tftypes.NewValue(tftypes.String, region)
+			}
+		}
+	}
+
+	return tftypes.NewValue(tftypes.String, nil)
+}
+
+func regionConversionError(err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Unable to Convert Value",
+		fmt.Sprintf("An unexpected error occurred while converting the per-resource %q attribute. "+
+			"This is always an issue with the Terraform Provider and should be reported to the provider developer.\n\n%s", names.AttrRegion, err),
+	)
+}
+
+// innerSchema returns the wrapped data source's own schema.
+func (w *wrappedDataSource) innerSchema(ctx context.Context) dsschema.Schema {
+	var response datasource.SchemaResponse
+	w.inner.Schema(ctx, datasource.SchemaRequest{}, &response)
+
+	return response.Schema
+}
+
+// innerSchema returns the wrapped resource's own schema.
+func (w *wrappedResource) innerSchema(ctx context.Context) schema.Schema {
+	var response resource.SchemaResponse
+	w.inner.Schema(ctx, resource.SchemaRequest{}, &response)
+
+	return response.Schema
+}
+
+// stateUpgradersWithRegion wraps the inner resource's state upgraders so that they are unaware of the `region` attribute.
+func (w *wrappedResource) stateUpgradersWithRegion(ctx context.Context, upgraders map[int64]resource.StateUpgrader) map[int64]resource.StateUpgrader {
+	innerSchema := w.innerSchema(ctx)
+	outerSchema := resourceSchemaWithRegion(innerSchema)
+	wrapped := make(map[int64]resource.StateUpgrader, len(upgraders))
+
+	for version, upgrader := range upgraders {
+		var priorSchema *schema.Schema
+		if v := upgrader.PriorSchema; v != nil {
+			s := resourceSchemaWithRegion(*v)
+			priorSchema = &s
+		}
+		f := upgrader.StateUpgrader
+
+		wrapped[version] = resource.StateUpgrader{
+			PriorSchema: priorSchema,
+			StateUpgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+				region := regionFromRawState(request.RawState)
+
+				if v := request.State; v != nil && upgrader.PriorSchema != nil {
+					raw, r, err := withoutRegion(v.Raw, upgrader.PriorSchema.Type().TerraformType(ctx))
+					if err != nil {
+						response.Diagnostics.Append(regionConversionError(err))
+						return
+					}
+					if stringFromValue(r) != "" {
+						region = r
+					}
+					state := *v
+					state.Raw = raw
+					state.Schema = *upgrader.PriorSchema
+					request.State = &state
+				}
+
+				response.State.Schema = innerSchema
+				f(ctx, request, response)
+
+				raw, err := withRegion(response.State.Raw, outerSchema.Type().TerraformType(ctx), region)
+				if err != nil {
+					response.Diagnostics.Append(regionConversionError(err))
+					return
+				}
+				response.State.Raw = raw
+				response.State.Schema = outerSchema
+			},
+		}
+	}
+
+	return wrapped
+}
+
+// regionFromValue returns the Go string value of the `region` attribute of the specified object value.
+func regionFromValue(v tftypes.Value) string {
+	if v.Type() == nil || !v.IsKnown() || v.IsNull() {
+		return ""
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return ""
+	}
+
+	return stringFromValue(attributes[names.AttrRegion])
+}
+
+// readWithoutRegion calls the inner data source's Read method with the `region` attribute removed.
+func (w *wrappedDataSource) readWithoutRegion(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	innerSchema := w.innerSchema(ctx)
+	innerType := innerSchema.Type().TerraformType(ctx)
+	outerSchema := response.State.Schema
+
+	config, region, err := withoutRegion(request.Config.Raw, innerType)
+	if err != nil {
+		response.Diagnostics.Append(regionConversionError(err))
+		return
+	}
+	request.Config.Raw, request.Config.Schema = config, innerSchema
+
+	state, _, err := withoutRegion(response.State.Raw, innerType)
+	if err != nil {
+		response.Diagnostics.Append(regionConversionError(err))
+		return
+	}
+	response.State.Raw, response.State.Schema = state, innerSchema
+
+	w.inner.Read(ctx, request, response)
+
+	state, err = withRegion(response.State.Raw, outerSchema.Type().TerraformType(ctx), resolvedRegion(ctx, w.meta, region))
+	if err != nil {
+		response.Diagnostics.Append(regionConversionError(err))
+		return
+	}
+	response.State.Raw, response.State.Schema = state, outerSchema
+}
+
+// resourceRegionSchemas holds a resource's own schema and the schema with the `region` attribute added.
+type resourceRegionSchemas struct {
+	inner     schema.Schema
+	innerType tftypes.Type
+	outer     schema.Schema
+	outerType tftypes.Type
+}
+
+func (w *wrappedResource) regionSchemas(ctx context.Context) resourceRegionSchemas {
+	inner := w.innerSchema(ctx)
+	outer := resourceSchemaWithRegion(inner)
+
+	return resourceRegionSchemas{
+		inner:     inner,
+		innerType: inner.Type().TerraformType(ctx),
+		outer:     outer,
+		outerType: outer.Type().TerraformType(ctx),
+	}
+}
+
+// strip removes the `region` attribute from the specified value, returning the removed value.
+func (s resourceRegionSchemas) strip(v tftypes.Value, diags *diag.Diagnostics) (tftypes.Value, tftypes.Value) {
+	v, region, err := withoutRegion(v, s.innerType)
+	if err != nil {
+		diags.Append(regionConversionError(err))
+	}
+
+	return v, region
+}
+
+// restore adds the `region` attribute to the specified value.
+func (s resourceRegionSchemas) restore(v, region tftypes.Value, diags *diag.Diagnostics) tftypes.Value {
+	v, err := withRegion(v, s.outerType, region)
+	if err != nil {
+		diags.Append(regionConversionError(err))
+	}
+
+	return v
+}
+
+// createWithoutRegion calls the inner resource's Create method with the `region` attribute removed.
+func (w *wrappedResource) createWithoutRegion(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	s := w.regionSchemas(ctx)
+
+	var region tftypes.Value
+	request.Config.Raw, _ = s.strip(request.Config.Raw, &response.Diagnostics)
+	request.Config.Schema = s.inner
+	request.Plan.Raw, region = s.strip(request.Plan.Raw, &response.Diagnostics)
+	request.Plan.Schema = s.inner
+	response.State.Raw, _ = s.strip(response.State.Raw, &response.Diagnostics)
+	response.State.Schema = s.inner
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Create(ctx, request, response)
+
+	response.State.Raw = s.restore(response.State.Raw, resolvedRegion(ctx, w.meta, region), &response.Diagnostics)
+	response.State.Schema = s.outer
+}
+
+// readWithoutRegion calls the inner resource's Read method with the `region` attribute removed.
+func (w *wrappedResource) readWithoutRegion(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	s := w.regionSchemas(ctx)
+
+	var region tftypes.Value
+	request.State.Raw, region = s.strip(request.State.Raw, &response.Diagnostics)
+	request.State.Schema = s.inner
+	response.State.Raw, _ = s.strip(response.State.Raw, &response.Diagnostics)
+	response.State.Schema = s.inner
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Read(ctx, request, response)
+
+	response.State.Raw = s.restore(response.State.Raw, resolvedRegion(ctx, w.meta, region), &response.Diagnostics)
+	response.State.Schema = s.outer
+}
+
+// updateWithoutRegion calls the inner resource's Update method with the `region` attribute removed.
+func (w *wrappedResource) updateWithoutRegion(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	s := w.regionSchemas(ctx)
+
+	var region tftypes.Value
+	request.Config.Raw, _ = s.strip(request.Config.Raw, &response.Diagnostics)
+	request.Config.Schema = s.inner
+	request.Plan.Raw, region = s.strip(request.Plan.Raw, &response.Diagnostics)
+	request.Plan.Schema = s.inner
+	request.State.Raw, _ = s.strip(request.State.Raw, &response.Diagnostics)
+	request.State.Schema = s.inner
+	response.State.Raw, _ = s.strip(response.State.Raw, &response.Diagnostics)
+	response.State.Schema = s.inner
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Update(ctx, request, response)
+
+	response.State.Raw = s.restore(response.State.Raw, resolvedRegion(ctx, w.meta, region), &response.Diagnostics)
+	response.State.Schema = s.outer
+}
+
+// deleteWithoutRegion calls the inner resource's Delete method with the `region` attribute removed.
+func (w *wrappedResource) deleteWithoutRegion(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	s := w.regionSchemas(ctx)
+
+	var region tftypes.Value
+	request.State.Raw, region = s.strip(request.State.Raw, &response.Diagnostics)
+	request.State.Schema = s.inner
+	response.State.Raw, _ = s.strip(response.State.Raw, &response.Diagnostics)
+	response.State.Schema = s.inner
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Delete(ctx, request, response)
+
+	response.State.Raw = s.restore(response.State.Raw, region, &response.Diagnostics)
+	response.State.Schema = s.outer
+}
+
+// importStateWithoutRegion calls the inner resource's ImportState method with the `region` attribute removed.
+// Any per-resource Region override is split from the import ID.
+func (w *wrappedResource) importStateWithoutRegion(ctx context.Context, inner resource.ResourceWithImportState, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	s := w.regionSchemas(ctx)
+
+	id, overrideRegion := regionFromImportID(request.ID)
+	request.ID = id
+	response.Diagnostics.Append(setOverrideRegion(ctx, w.meta, overrideRegion)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	region := tftypes.NewValue(tftypes.String, nil)
+	if overrideRegion != "" {
+		region = tftypes.NewValue(tftypes.String, overrideRegion)
+	}
+
+	response.State.Raw, _ = s.strip(response.State.Raw, &response.Diagnostics)
+	response.State.Schema = s.inner
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	inner.ImportState(ctx, request, response)
+
+	response.State.Raw = s.restore(response.State.Raw, region, &response.Diagnostics)
+	response.State.Schema = s.outer
+}
+
+// modifyPlanWithoutRegion calls the inner resource's ModifyPlan method with the `region` attribute removed.
+func (w *wrappedResource) modifyPlanWithoutRegion(ctx context.Context, inner resource.ResourceWithModifyPlan, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	s := w.regionSchemas(ctx)
+
+	var region tftypes.Value
+	request.Config.Raw, _ = s.strip(request.Config.Raw, &response.Diagnostics)
+	request.Config.Schema = s.inner
+	request.Plan.Raw, _ = s.strip(request.Plan.Raw, &response.Diagnostics)
+	request.Plan.Schema = s.inner
+	request.State.Raw, _ = s.strip(request.State.Raw, &response.Diagnostics)
+	request.State.Schema = s.inner
+	response.Plan.Raw, region = s.strip(response.Plan.Raw, &response.Diagnostics)
+	response.Plan.Schema = s.inner
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	inner.ModifyPlan(ctx, request, response)
+
+	response.Plan.Raw = s.restore(response.Plan.Raw, region, &response.Diagnostics)
+	response.Plan.Schema = s.outer
+}
+
+// validateConfigWithoutRegion calls the inner resource's ValidateConfig method with the `region` attribute removed.
+func (w *wrappedResource) validateConfigWithoutRegion(ctx context.Context, inner resource.ResourceWithValidateConfig, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	s := w.regionSchemas(ctx)
+
+	request.Config.Raw, _ = s.strip(request.Config.Raw, &response.Diagnostics)
+	request.Config.Schema = s.inner
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	inner.ValidateConfig(ctx, request, response)
+}
+
+// stateMoversWithRegion wraps the inner resource's state movers so that they are unaware of the `region` attribute.
+// The moved resource is managed in the provider's configured Region.
+func (w *wrappedResource) stateMoversWithRegion(ctx context.Context, movers []resource.StateMover) []resource.StateMover {
+	s := w.regionSchemas(ctx)
+	wrapped := make([]resource.StateMover, 0, len(movers))
+
+	for _, mover := range movers {
+		f := mover.StateMover
+
+		wrapped = append(wrapped, resource.StateMover{
+			SourceSchema: mover.SourceSchema,
+			StateMover: func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+				response.TargetState.Raw, _ = s.strip(response.TargetState.Raw, &response.Diagnostics)
+				response.TargetState.Schema = s.inner
+
+				if response.Diagnostics.HasError() {
+					return
+				}
+
+				f(ctx, request, response)
+
+				response.TargetState.Raw = s.restore(response.TargetState.Raw, tftypes.NewValue(tftypes.String, nil), &response.Diagnostics)
+				response.TargetState.Schema = s.outer
+			},
+		})
+	}
+
+	return wrapped
+}
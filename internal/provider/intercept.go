@@ -79,6 +79,21 @@ const (
 	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
 )
 
+func (why why) String() string {
+	switch why {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	default:
+		return ""
+	}
+}
+
 type interceptorItems []interceptorItem
 
 // why returns a slice of interceptors that run for the specified CRUD operation.
@@ -93,6 +108,7 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		var diags diag.Diagnostics
 		ctx = bootstrapContext(ctx, meta)
+		conns.SetTerraformOperation(ctx, why.String())
 		// Before interceptors are run first to last.
 		forward := interceptors.why(why)
 
@@ -153,6 +169,8 @@ type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     interceptorItems
+	// regionAttribute is true if the provider injected the `region` argument into the resource's schema.
+	regionAttribute bool
 }
 
 func (r *wrappedResource) Create(f schema.CreateContextFunc) schema.CreateContextFunc {
@@ -174,6 +192,13 @@ func (r *wrappedResource) Delete(f schema.DeleteContextFunc) schema.DeleteContex
 func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = r.bootstrapContext(ctx, meta)
+		conns.SetTerraformOperation(ctx, "ImportState")
+
+		if r.regionAttribute {
+			if err := importRegion(ctx, d, meta); err != nil {
+				return nil, err
+			}
+		}
 
 		return f(ctx, d, meta)
 	}
@@ -182,6 +207,18 @@ func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFu
 func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 		ctx = r.bootstrapContext(ctx, meta)
+		conns.SetTerraformOperation(ctx, "CustomizeDiff")
+
+		if r.regionAttribute {
+			region, _ := d.Get(names.AttrRegion).(string)
+			if err := setOverrideRegion(ctx, meta, region); err != nil {
+				return err
+			}
+		}
+
+		if f == nil {
+			return nil
+		}
 
 		return f(ctx, d, meta)
 	}
@@ -190,6 +227,7 @@ func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.Custo
 func (r *wrappedResource) StateUpgrade(f schema.StateUpgradeFunc) schema.StateUpgradeFunc {
 	return func(ctx context.Context, rawState map[string]interface{}, meta any) (map[string]interface{}, error) {
 		ctx = r.bootstrapContext(ctx, meta)
+		conns.SetTerraformOperation(ctx, "UpgradeState")
 
 		return f(ctx, rawState, meta)
 	}
@@ -52,6 +52,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"api_call_telemetry_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "File to which a JSON summary of AWS API calls is written when the provider exits. " +
+					"Can also be configured using the `TF_AWS_API_CALL_TELEMETRY_FILE` environment variable.",
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 			"custom_ca_bundle": {
@@ -78,6 +84,34 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"deletion_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to deny the deletion of resources. Deletion of a resource matching any of the settings fails without calling the AWS API.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arns": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "ARNs of resources whose deletion is denied.",
+						},
+						"resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Types of resources whose deletion is denied. Types may contain `*` and `?` wildcards, e.g. `aws_db_*`.",
+						},
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Tags of resources whose deletion is denied. A tag value of `*` matches any value.",
+						},
+					},
+				},
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -152,6 +186,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. " +
 					"Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"offline_cassette_dir": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Directory of recorded cassettes from which AWS API responses are served. No requests are sent to AWS. " +
+					"Can also be configured using the `TF_AWS_OFFLINE_CASSETTE_DIR` environment variable.",
+			},
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -191,6 +231,39 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_rate_limits": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with client-side rate limits on the AWS API calls made to individual services.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The maximum number of API requests made at once above the sustained rate. Defaults to 1.",
+						},
+						"max_concurrency": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The maximum number of in-flight API requests. Zero means no limit.",
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+							Description:  "The sustained rate of API requests. Zero means no limit.",
+						},
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(names.ProviderPackages(), false),
+							Description:  "The service, e.g. `route53`. Service name aliases are not supported.",
+						},
+					},
+				},
+			},
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -293,7 +366,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
@@ -303,6 +376,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			// Inject the per-resource `region` argument into the schema of any data source that doesn't already have one.
+			if injectRegionAttribute(r, dataSourceRegionSchema()) {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -369,7 +451,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
@@ -379,6 +461,23 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			// Inject the per-resource `region` argument into the schema of any resource that doesn't already have one.
+			regionAttribute := injectRegionAttribute(r, resourceRegionSchema())
+			if regionAttribute {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionInterceptor{},
+				})
+			}
+
+			// Enforce the provider's `deletion_policy` on all resources.
+			interceptors = append(interceptors, interceptorItem{
+				when:        Before,
+				why:         Delete,
+				interceptor: newDeletionPolicyInterceptor(r.SchemaMap()),
+			})
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -417,6 +516,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
+				regionAttribute:  regionAttribute,
 			}
 
 			if v := r.CreateWithoutTimeout; v != nil {
@@ -436,7 +536,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					r.Importer.StateContext = rs.State(v)
 				}
 			}
-			if v := r.CustomizeDiff; v != nil {
+			// Any per-resource Region override is validated at plan time, so the resource needs a CustomizeDiff.
+			if v := r.CustomizeDiff; v != nil || regionAttribute {
 				r.CustomizeDiff = rs.CustomizeDiff(v)
 			}
 			for _, stateUpgrader := range r.StateUpgraders {
@@ -518,6 +619,12 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.Get("api_call_telemetry_file").(string); ok && v != "" {
+		config.APICallTelemetryFile = v
+	} else {
+		config.APICallTelemetryFile = os.Getenv(conns.APICallTelemetryFileEnvVar)
+	}
+
 	if v, ok := d.GetOk("assume_role"); ok {
 		path := cty.GetAttrPath("assume_role")
 		v := v.([]any)
@@ -565,6 +672,18 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.DefaultTagsConfig = expandDefaultTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("deletion_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.DeletionPolicy = expandDeletionPolicy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("service_rate_limits"); ok && len(v.([]interface{})) > 0 {
+		limits, err := expandServiceRateLimits(v.([]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.ServiceRateLimits = limits
+	}
+
 	v := d.Get("endpoints")
 	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
 	diags = append(diags, dx...)
@@ -592,6 +711,12 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.NoProxy = v
 	}
 
+	if v, ok := d.Get("offline_cassette_dir").(string); ok && v != "" {
+		config.OfflineCassetteDir = v
+	} else {
+		config.OfflineCassetteDir = os.Getenv(conns.OfflineCassetteDirEnvVar)
+	}
+
 	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	} else {
@@ -894,6 +1019,58 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return nil
 }
 
+func expandDeletionPolicy(tfMap map[string]interface{}) *conns.DeletionPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	policy := &conns.DeletionPolicy{}
+
+	if v, ok := tfMap["arns"].(*schema.Set); ok && v.Len() > 0 {
+		policy.ARNs = flex.ExpandStringValueSet(v)
+	}
+	if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		policy.ResourceTypes = flex.ExpandStringValueSet(v)
+	}
+	if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+		policy.Tags = flex.ExpandStringValueMap(v)
+	}
+
+	return policy
+}
+
+func expandServiceRateLimits(tfList []interface{}) (map[string]conns.ServiceRateLimit, error) {
+	limits := make(map[string]conns.ServiceRateLimit, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		service := tfMap["service"].(string)
+		if _, ok := limits[service]; ok {
+			return nil, fmt.Errorf("duplicate service_rate_limits for service %q", service)
+		}
+
+		limit := conns.ServiceRateLimit{}
+
+		if v, ok := tfMap["burst"].(int); ok {
+			limit.Burst = v
+		}
+		if v, ok := tfMap["max_concurrency"].(int); ok {
+			limit.MaxConcurrency = v
+		}
+		if v, ok := tfMap["requests_per_second"].(float64); ok {
+			limit.RequestsPerSecond = v
+		}
+
+		limits[service] = limit
+	}
+
+	return limits, nil
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []interface{}
 
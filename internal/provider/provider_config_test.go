@@ -40,7 +40,7 @@ region = us-west-2
 `, //lintignore:AWSAT003
 			Check: func(t *testing.T, meta *conns.AWSClient) {
 				//lintignore:AWSAT003
-				if a, e := meta.Region, "us-west-2"; a != e {
+				if a, e := meta.Region(context.Background()), "us-west-2"; a != e {
 					t.Errorf("expected region %q, got %q", e, a)
 				}
 			},
@@ -53,7 +53,7 @@ region = us-west-2
 	`, //lintignore:AWSAT003
 			Check: func(t *testing.T, meta *conns.AWSClient) {
 				//lintignore:AWSAT003
-				if a, e := meta.Region, "us-west-2"; a != e {
+				if a, e := meta.Region(context.Background()), "us-west-2"; a != e {
 					t.Errorf("expected region %q, got %q", e, a)
 				}
 			},
@@ -67,7 +67,7 @@ region = us-west-2
 		`, //lintignore:AWSAT003
 			Check: func(t *testing.T, meta *conns.AWSClient) {
 				//lintignore:AWSAT003
-				if a, e := meta.Region, "us-west-2"; a != e {
+				if a, e := meta.Region(context.Background()), "us-west-2"; a != e {
 					t.Errorf("expected region %q, got %q", e, a)
 				}
 			},
@@ -87,7 +87,7 @@ region = us-west-2
 			`, //lintignore:AWSAT003
 			Check: func(t *testing.T, meta *conns.AWSClient) {
 				//lintignore:AWSAT003
-				if a, e := meta.Region, "us-east-1"; a != e {
+				if a, e := meta.Region(context.Background()), "us-east-1"; a != e {
 					t.Errorf("expected region %q, got %q", e, a)
 				}
 			},
@@ -106,7 +106,7 @@ region = us-east-1
 `, //lintignore:AWSAT003
 			Check: func(t *testing.T, meta *conns.AWSClient) {
 				//lintignore:AWSAT003
-				if a, e := meta.Region, "us-east-1"; a != e {
+				if a, e := meta.Region(context.Background()), "us-east-1"; a != e {
 					t.Errorf("expected region %q, got %q", e, a)
 				}
 			},
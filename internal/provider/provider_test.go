@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -422,6 +423,76 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandServiceRateLimits(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tfList        []interface{}
+		expected      map[string]conns.ServiceRateLimit
+		expectedError bool
+	}{
+		"empty": {
+			expected: map[string]conns.ServiceRateLimit{},
+		},
+		"multiple services": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"service":             "route53",
+					"requests_per_second": 5.0,
+					"burst":               0,
+					"max_concurrency":     0,
+				},
+				map[string]interface{}{
+					"service":             "organizations",
+					"requests_per_second": 0.0,
+					"burst":               0,
+					"max_concurrency":     2,
+				},
+			},
+			expected: map[string]conns.ServiceRateLimit{
+				"route53": {
+					RequestsPerSecond: 5,
+				},
+				"organizations": {
+					MaxConcurrency: 2,
+				},
+			},
+		},
+		"duplicate service": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"service":             "iam",
+					"requests_per_second": 5.0,
+				},
+				map[string]interface{}{
+					"service":             "iam",
+					"requests_per_second": 10.0,
+				},
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandServiceRateLimits(testCase.tfList)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("expandServiceRateLimits err %q, want error: %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// importIDRegionSeparator separates a resource's import ID from any per-resource Region override,
+	// e.g. `terraform import aws_sqs_queue.example https://sqs.eu-west-1.amazonaws.com/123456789012/example@eu-west-1`.
+	importIDRegionSeparator = "@"
+)
+
+// dataSourceRegionSchema returns the schema of the `region` argument injected into data sources.
+func dataSourceRegionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region in which the data source's API calls are made. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// resourceRegionSchema returns the schema of the `region` argument injected into resources.
+func resourceRegionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// injectRegionAttribute adds the `region` argument to the specified resource's schema.
+// It returns false if the schema already defines a `region` attribute.
+func injectRegionAttribute(r *schema.Resource, s *schema.Schema) bool {
+	if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+		return false
+	}
+
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			m := f()
+			m[names.AttrRegion] = s
+			return m
+		}
+	} else {
+		if r.Schema == nil {
+			r.Schema = make(map[string]*schema.Schema)
+		}
+		r.Schema[names.AttrRegion] = s
+	}
+
+	return true
+}
+
+// setOverrideRegion records any per-resource Region override in Context.
+// An error is returned if the override is not in the provider's configured partition.
+func setOverrideRegion(ctx context.Context, meta any, region string) error {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	// Clear any existing override so that the provider's configured Region is compared.
+	inContext.OverrideRegion = ""
+	if c, ok := meta.(*conns.AWSClient); ok {
+		if region == c.Region(ctx) {
+			region = ""
+		}
+		if err := c.ValidateOverrideRegion(ctx, region); err != nil {
+			return fmt.Errorf("%s: %w", names.AttrRegion, err)
+		}
+	}
+	inContext.OverrideRegion = region
+
+	return nil
+}
+
+// regionInterceptor implements the per-resource `region` argument.
+// It must run before any other interceptor so that their API clients are for the correct Region.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		// On Create the value comes from configuration and is empty if not set.
+		// Otherwise it comes from state.
+		region, _ := d.Get(names.AttrRegion).(string)
+		if err := setOverrideRegion(ctx, meta, region); err != nil {
+			return ctx, sdkdiag.AppendFromErr(diags, err)
+		}
+	case After:
+		switch why {
+		case Create, Read, Update:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			if v, _ := d.Get(names.AttrRegion).(string); v == "" {
+				if err := d.Set(names.AttrRegion, c.Region(ctx)); err != nil {
+					return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+				}
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// importRegion handles any per-resource Region override in a resource's import ID.
+func importRegion(ctx context.Context, d *schema.ResourceData, meta any) error {
+	id := d.Id()
+	if i := strings.LastIndex(id, importIDRegionSeparator); i > 0 {
+		if region := id[i+len(importIDRegionSeparator):]; itypes.IsAWSRegion(region) {
+			d.SetId(id[:i])
+			if err := d.Set(names.AttrRegion, region); err != nil {
+				return err
+			}
+			return setOverrideRegion(ctx, meta, region)
+		}
+	}
+
+	return nil
+}
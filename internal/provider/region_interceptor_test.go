@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestInjectRegionAttribute(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+	if got, want := injectRegionAttribute(r, resourceRegionSchema()), true; got != want {
+		t.Errorf("injectRegionAttribute = %v, want %v", got, want)
+	}
+	if _, ok := r.SchemaMap()[names.AttrRegion]; !ok {
+		t.Errorf("no %s attribute injected", names.AttrRegion)
+	}
+	if got, want := injectRegionAttribute(r, resourceRegionSchema()), false; got != want {
+		t.Errorf("injectRegionAttribute = %v, want %v", got, want)
+	}
+
+	r = &schema.Resource{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			}
+		},
+	}
+	if got, want := injectRegionAttribute(r, resourceRegionSchema()), true; got != want {
+		t.Errorf("injectRegionAttribute = %v, want %v", got, want)
+	}
+	if _, ok := r.SchemaMap()[names.AttrRegion]; !ok {
+		t.Errorf("no %s attribute injected", names.AttrRegion)
+	}
+}
+
+func TestRegionInterceptor(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		region       string
+		expectedAPI  string
+		expectedSet  string
+		expectedOver string
+		expectError  bool
+	}{
+		"not configured": {
+			expectedAPI: "us-west-2", //lintignore:AWSAT003
+			expectedSet: "us-west-2", //lintignore:AWSAT003
+		},
+		"same as provider": {
+			region:      "us-west-2", //lintignore:AWSAT003
+			expectedAPI: "us-west-2", //lintignore:AWSAT003
+		},
+		"override": {
+			region:       "eu-west-1", //lintignore:AWSAT003
+			expectedAPI:  "eu-west-1", //lintignore:AWSAT003
+			expectedOver: "eu-west-1", //lintignore:AWSAT003
+		},
+		"other partition": {
+			region:      "cn-north-1", //lintignore:AWSAT003
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := conns.NewResourceContext(context.Background(), "Test", "Test", "aws_test")
+			meta := &conns.AWSClient{
+				Partition: names.StandardPartitionID,
+			}
+			meta.SetRegion(ctx, "us-west-2") //lintignore:AWSAT003
+			d := &regionResourceData{region: testCase.region}
+
+			var diags diag.Diagnostics
+			ctx, diags = regionInterceptor{}.run(ctx, d, meta, Before, Create, diags)
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Fatalf("got error %v, expected error %t", diags, want)
+			}
+			if testCase.expectError {
+				return
+			}
+
+			inContext, _ := conns.FromContext(ctx)
+			if got, want := inContext.OverrideRegion, testCase.expectedOver; got != want {
+				t.Errorf("OverrideRegion = %q, want %q", got, want)
+			}
+			if got, want := meta.Region(ctx), testCase.expectedAPI; got != want {
+				t.Errorf("Region = %q, want %q", got, want)
+			}
+
+			_, diags = regionInterceptor{}.run(ctx, d, meta, After, Create, diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := d.set, testCase.expectedSet; got != want {
+				t.Errorf("set %s = %q, want %q", names.AttrRegion, got, want)
+			}
+		})
+	}
+}
+
+func TestImportRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id             string
+		expectedID     string
+		expectedRegion string
+	}{
+		"no Region": {
+			id:         "example",
+			expectedID: "example",
+		},
+		"Region": {
+			id:             "example@eu-west-1", //lintignore:AWSAT003
+			expectedID:     "example",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		"email address": {
+			id:         "someone@example.com",
+			expectedID: "someone@example.com",
+		},
+		"email address and Region": {
+			id:             "someone@example.com@eu-west-1", //lintignore:AWSAT003
+			expectedID:     "someone@example.com",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrRegion: resourceRegionSchema(),
+				},
+			}
+			d := r.TestResourceData()
+			d.SetId(testCase.id)
+			ctx := conns.NewResourceContext(context.Background(), "Test", "Test", "aws_test")
+			meta := &conns.AWSClient{}
+			meta.SetRegion(ctx, "us-west-2") //lintignore:AWSAT003
+
+			if err := importRegion(ctx, d, meta); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := d.Id(), testCase.expectedID; got != want {
+				t.Errorf("Id = %q, want %q", got, want)
+			}
+			if got, want := d.Get(names.AttrRegion).(string), testCase.expectedRegion; got != want {
+				t.Errorf("%s = %q, want %q", names.AttrRegion, got, want)
+			}
+		})
+	}
+}
+
+type regionResourceData struct {
+	resourceData
+	region string
+	set    string
+}
+
+func (d *regionResourceData) Get(key string) any {
+	if key == names.AttrRegion {
+		return d.region
+	}
+	return nil
+}
+
+func (d *regionResourceData) Set(key string, v any) error {
+	if key == names.AttrRegion {
+		d.set = v.(string)
+	}
+	return nil
+}
@@ -133,7 +133,7 @@ func resourceAPIKeyRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	setTagsOut(ctx, apiKey.Tags)
 
-	d.Set(names.AttrARN, apiKeyARN(meta.(*conns.AWSClient), d.Id()))
+	d.Set(names.AttrARN, apiKeyARN(ctx, meta.(*conns.AWSClient), d.Id()))
 	d.Set(names.AttrCreatedDate, apiKey.CreatedDate.Format(time.RFC3339))
 	d.Set("customer_id", apiKey.CustomerId)
 	d.Set(names.AttrDescription, apiKey.Description)
@@ -251,11 +251,11 @@ func findAPIKeyByID(ctx context.Context, conn *apigateway.Client, id string) (*a
 	return output, nil
 }
 
-func apiKeyARN(c *conns.AWSClient, id string) string {
+func apiKeyARN(ctx context.Context, c *conns.AWSClient, id string) string {
 	return arn.ARN{
 		Partition: c.Partition,
 		Service:   "apigateway",
-		Region:    c.Region,
+		Region:    c.Region(ctx),
 		Resource:  fmt.Sprintf("/apikeys/%s", id),
 	}.String()
 }
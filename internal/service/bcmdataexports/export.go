@@ -224,7 +224,7 @@ func (r *resourceExport) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	in := &bcmdataexports.CreateExportInput{}
-	resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+	resp.Diagnostics.Append(autoFlexExpandResourceExportDataToCreateExportInput(ctx, &plan, in)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -260,7 +260,7 @@ func (r *resourceExport) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	resp.Diagnostics.Append(flex.Flatten(ctx, outputRaw, &plan)...)
+	resp.Diagnostics.Append(autoFlexFlattenGetExportOutputToResourceExportData(ctx, outputRaw, &plan)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -293,7 +293,7 @@ func (r *resourceExport) Read(ctx context.Context, req resource.ReadRequest, res
 
 	state.ID = flex.StringToFramework(ctx, out.Export.ExportArn)
 
-	resp.Diagnostics.Append(flex.Flatten(ctx, out, &state)...)
+	resp.Diagnostics.Append(autoFlexFlattenGetExportOutputToResourceExportData(ctx, out, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -314,7 +314,7 @@ func (r *resourceExport) Update(ctx context.Context, req resource.UpdateRequest,
 
 	if !plan.Export.Equal(state.Export) {
 		in := &bcmdataexports.UpdateExportInput{}
-		resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+		resp.Diagnostics.Append(autoFlexExpandResourceExportDataToUpdateExportInput(ctx, &plan, in)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -460,6 +460,9 @@ func findExportByID(ctx context.Context, conn *bcmdataexports.Client, exportArn
 	return out, nil
 }
 
+// @AutoFlexExpand("bcmdataexports.CreateExportInput")
+// @AutoFlexExpand("bcmdataexports.UpdateExportInput")
+// @AutoFlexFlatten("bcmdataexports.GetExportOutput")
 type resourceExportData struct {
 	Export   fwtypes.ListNestedObjectValueOf[exportData] `tfsdk:"export"`
 	ID       types.String                                `tfsdk:"id"`
@@ -4,168 +4,103 @@
 package ecs
 
 import (
+	"encoding/json"
 	"fmt"
-	"sort"
 	_ "unsafe" // Required for go:linkname
 
-	"github.com/aws/aws-sdk-go-v2/aws"
 	_ "github.com/aws/aws-sdk-go-v2/service/ecs" // Required for go:linkname
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	smithyjson "github.com/aws/smithy-go/encoding/json"
 	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func containerDefinitionsAreEquivalent(def1, def2 string, isAWSVPC bool) (bool, error) {
-	var obj1 containerDefinitions
-	err := tfjson.DecodeFromString(def1, &obj1)
-	if err != nil {
-		return false, err
-	}
-	obj1.reduce(isAWSVPC)
-	b1, err := tfjson.EncodeToBytes(obj1)
+	n1, err := normalizeContainerDefinitions(def1, isAWSVPC)
 	if err != nil {
 		return false, err
 	}
 
-	var obj2 containerDefinitions
-	err = tfjson.DecodeFromString(def2, &obj2)
-	if err != nil {
-		return false, err
-	}
-	obj2.reduce(isAWSVPC)
-	b2, err := tfjson.EncodeToBytes(obj2)
+	n2, err := normalizeContainerDefinitions(def2, isAWSVPC)
 	if err != nil {
 		return false, err
 	}
 
-	return tfjson.EqualBytes(b1, b2), nil
+	return n1 == n2, nil
 }
 
-type containerDefinitions []awstypes.ContainerDefinition
-
-func (cd containerDefinitions) reduce(isAWSVPC bool) {
-	// Deal with fields which may be re-ordered in the API.
-	cd.orderContainers()
-	cd.orderEnvironmentVariables()
-	cd.orderSecrets()
+// normalizeContainerDefinitions returns the canonical form of the specified container definitions JSON.
+// The definitions are first decoded into the API types, which ignores the case of member names and unknown members.
+func normalizeContainerDefinitions(s string, isAWSVPC bool) (string, error) {
+	var apiObjects containerDefinitions
+	if err := tfjson.DecodeFromString(s, &apiObjects); err != nil {
+		return "", err
+	}
 
 	// Compact any sparse lists.
-	cd.compactArrays()
+	apiObjects.compactArrays()
 
-	// Deal with special fields which have defaults.
-	// See https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task_definition_parameters.html#container_definitions.
-	for i, def := range cd {
-		if def.Essential == nil {
-			cd[i].Essential = aws.Bool(true)
-		}
+	b, err := tfjson.EncodeToBytes(apiObjects)
+	if err != nil {
+		return "", err
+	}
 
-		if hc := def.HealthCheck; hc != nil {
-			if hc.Interval == nil {
-				hc.Interval = aws.Int32(30)
-			}
-			if hc.Retries == nil {
-				hc.Retries = aws.Int32(3)
-			}
-			if hc.Timeout == nil {
-				hc.Timeout = aws.Int32(5)
-			}
-		}
+	return tfjson.Normalize(string(b),
+		tfjson.NormalizeNumbers,
+		normalizePortMappings(isAWSVPC),
+		// Deal with special fields which have defaults.
+		// See https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task_definition_parameters.html#container_definitions.
+		tfjson.StripDefaults(map[string]any{
+			"Essential": true,
+			"Interval":  30,
+			"Retries":   3,
+			"Timeout":   5,
+		}),
+		tfjson.StripEmpty,
+		// Deal with fields which may be re-ordered in the API, e.g. containers, environment variables and secrets.
+		tfjson.SortArraysByField("Name"),
+	)
+}
 
-		for j, pm := range def.PortMappings {
-			if pm.Protocol == awstypes.TransportProtocolTcp {
-				cd[i].PortMappings[j].Protocol = ""
-			}
-			if aws.ToInt32(pm.HostPort) == 0 {
-				cd[i].PortMappings[j].HostPort = nil
-			}
-			if isAWSVPC && cd[i].PortMappings[j].HostPort == nil {
-				cd[i].PortMappings[j].HostPort = cd[i].PortMappings[j].ContainerPort
+// normalizePortMappings returns a Normalizer that removes the defaults that AWS adds to decoded container definitions' port mappings.
+// In awsvpc network mode the host port defaults to the container port.
+func normalizePortMappings(isAWSVPC bool) tfjson.Normalizer {
+	return func(v any) any {
+		defs, _ := v.([]any)
+		for _, def := range defs {
+			def, _ := def.(map[string]any)
+			portMappings, _ := def["PortMappings"].([]any)
+			for _, pm := range portMappings {
+				pm, ok := pm.(map[string]any)
+				if !ok {
+					continue
+				}
+
+				if pm["Protocol"] == string(awstypes.TransportProtocolTcp) {
+					pm["Protocol"] = ""
+				}
+				if pm["HostPort"] == json.Number("0") {
+					pm["HostPort"] = nil
+				}
+				if isAWSVPC && pm["HostPort"] == nil {
+					pm["HostPort"] = pm["ContainerPort"]
+				}
 			}
 		}
 
-		// Set all empty slices to nil.
-		if len(def.Command) == 0 {
-			cd[i].Command = nil
-		}
-		if len(def.CredentialSpecs) == 0 {
-			cd[i].CredentialSpecs = nil
-		}
-		if len(def.DependsOn) == 0 {
-			cd[i].DependsOn = nil
-		}
-		if len(def.DnsSearchDomains) == 0 {
-			cd[i].DnsSearchDomains = nil
-		}
-		if len(def.DnsServers) == 0 {
-			cd[i].DnsServers = nil
-		}
-		if len(def.DockerSecurityOptions) == 0 {
-			cd[i].DockerSecurityOptions = nil
-		}
-		if len(def.EntryPoint) == 0 {
-			cd[i].EntryPoint = nil
-		}
-		if len(def.Environment) == 0 {
-			cd[i].Environment = nil
-		}
-		if len(def.EnvironmentFiles) == 0 {
-			cd[i].EnvironmentFiles = nil
-		}
-		if len(def.ExtraHosts) == 0 {
-			cd[i].ExtraHosts = nil
-		}
-		if len(def.Links) == 0 {
-			cd[i].Links = nil
-		}
-		if len(def.MountPoints) == 0 {
-			cd[i].MountPoints = nil
-		}
-		if len(def.PortMappings) == 0 {
-			cd[i].PortMappings = nil
-		}
-		if len(def.ResourceRequirements) == 0 {
-			cd[i].ResourceRequirements = nil
-		}
-		if len(def.Secrets) == 0 {
-			cd[i].Secrets = nil
-		}
-		if len(def.SystemControls) == 0 {
-			cd[i].SystemControls = nil
-		}
-		if len(def.Ulimits) == 0 {
-			cd[i].Ulimits = nil
-		}
-		if len(def.VolumesFrom) == 0 {
-			cd[i].VolumesFrom = nil
-		}
-	}
-}
-
-func (cd containerDefinitions) orderEnvironmentVariables() {
-	for i, def := range cd {
-		sort.Slice(def.Environment, func(i, j int) bool {
-			return aws.ToString(def.Environment[i].Name) < aws.ToString(def.Environment[j].Name)
-		})
-		cd[i].Environment = def.Environment
+		return v
 	}
 }
 
-func (cd containerDefinitions) orderSecrets() {
-	for i, def := range cd {
-		sort.Slice(def.Secrets, func(i, j int) bool {
-			return aws.ToString(def.Secrets[i].Name) < aws.ToString(def.Secrets[j].Name)
-		})
-		cd[i].Secrets = def.Secrets
-	}
+// orderContainerDefinitions sorts the containers, and each container's environment variables and secrets,
+// in the specified container definitions JSON, so that AWS reordering them doesn't cause spurious differences.
+func orderContainerDefinitions(s string) (string, error) {
+	return tfjson.Normalize(s, tfjson.SortArraysByField(names.AttrName))
 }
 
-func (cd containerDefinitions) orderContainers() {
-	sort.Slice(cd, func(i, j int) bool {
-		return aws.ToString(cd[i].Name) < aws.ToString(cd[j].Name)
-	})
-}
+type containerDefinitions []awstypes.ContainerDefinition
 
 // compactArrays removes any zero values from the object arrays in the container definitions.
 func (cd containerDefinitions) compactArrays() {
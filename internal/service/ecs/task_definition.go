@@ -20,7 +20,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -86,18 +85,14 @@ func resourceTaskDefinition() *schema.Resource {
 					// Sort the lists of environment variables as they are serialized to state, so we won't get
 					// spurious reorderings in plans (diff is suppressed if the environment variables haven't changed,
 					// but they still show in the plan if some other property changes).
-					orderedCDs, err := expandContainerDefinitions(v.(string))
+					cds, err := expandContainerDefinitions(v.(string))
 					if err != nil {
 						// e.g. The value is unknown ("74D93920-ED26-11E3-AC10-0800200C9A66").
 						// Mimic the pre-v5.59.0 behavior.
 						return "[]"
 					}
-					containerDefinitions(orderedCDs).orderContainers()
-					containerDefinitions(orderedCDs).orderEnvironmentVariables()
-					containerDefinitions(orderedCDs).orderSecrets()
-					containerDefinitions(orderedCDs).compactArrays()
-					unnormalizedJson, _ := flattenContainerDefinitions(orderedCDs)
-					json, _ := structure.NormalizeJsonString(unnormalizedJson)
+					unorderedJSON, _ := flattenContainerDefinitions(cds)
+					json, _ := orderContainerDefinitions(unorderedJSON)
 					return json
 				},
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
@@ -629,14 +624,14 @@ func resourceTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, met
 	// Sort the lists of environment variables as they come in, so we won't get spurious reorderings in plans
 	// (diff is suppressed if the environment variables haven't changed, but they still show in the plan if
 	// some other property changes).
-	containerDefinitions(taskDefinition.ContainerDefinitions).orderContainers()
-	containerDefinitions(taskDefinition.ContainerDefinitions).orderEnvironmentVariables()
-	containerDefinitions(taskDefinition.ContainerDefinitions).orderSecrets()
-
 	defs, err := flattenContainerDefinitions(taskDefinition.ContainerDefinitions)
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
+	defs, err = orderContainerDefinitions(defs)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 	d.Set("container_definitions", defs)
 
 	setTagsOut(ctx, tags)
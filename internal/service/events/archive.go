@@ -53,6 +53,7 @@ func resourceArchive() *schema.Resource {
 					json, _ := structure.NormalizeJsonString(v.(string))
 					return json
 				},
+				DiffSuppressFunc: verify.SuppressEquivalentNormalizedJSONDiffs(eventPatternNormalizers...),
 			},
 			"event_source_arn": {
 				Type:         schema.TypeString,
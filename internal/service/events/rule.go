@@ -4,9 +4,7 @@
 package events
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -25,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -79,6 +78,7 @@ func resourceRule() *schema.Resource {
 					json, _ := ruleEventPatternJSONDecoder(v.(string))
 					return json
 				},
+				DiffSuppressFunc: verify.SuppressEquivalentNormalizedJSONDiffs(eventPatternNormalizers...),
 			},
 			names.AttrForceDestroy: {
 				Type:     schema.TypeBool,
@@ -117,7 +117,7 @@ func resourceRule() *schema.Resource {
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNOf("iam", "role/*"),
 			},
 			names.AttrScheduleExpression: {
 				Type:         schema.TypeString,
@@ -399,32 +399,24 @@ func ruleParseResourceID(id string) (string, string, error) {
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected EVENTBUSNAME%[2]sRULENAME or RULENAME", id, ruleResourceIDSeparator)
 }
 
-// ruleEventPatternJSONDecoder decodes unicode translation of <,>,&
-func ruleEventPatternJSONDecoder(jsonString interface{}) (string, error) {
-	var j interface{}
+// eventPatternNormalizers normalize event patterns so that AWS reformatting a pattern doesn't produce a difference.
+var eventPatternNormalizers = []tfjson.Normalizer{tfjson.NormalizeNumbers}
 
+// ruleEventPatternJSONDecoder returns the normalized form of an event pattern, decoding the unicode translation of <,>,&
+// used in e.g. numeric matching.
+func ruleEventPatternJSONDecoder(jsonString interface{}) (string, error) {
 	if jsonString == nil || jsonString.(string) == "" {
 		return "", nil
 	}
 
 	s := jsonString.(string)
 
-	err := json.Unmarshal([]byte(s), &j)
+	v, err := tfjson.Normalize(s, eventPatternNormalizers...)
 	if err != nil {
 		return s, err
 	}
 
-	b, err := json.Marshal(j)
-	if err != nil {
-		return "", err
-	}
-
-	if bytes.Contains(b, []byte("\\u003c")) || bytes.Contains(b, []byte("\\u003e")) || bytes.Contains(b, []byte("\\u0026")) {
-		b = bytes.Replace(b, []byte("\\u003c"), []byte("<"), -1)
-		b = bytes.Replace(b, []byte("\\u003e"), []byte(">"), -1)
-		b = bytes.Replace(b, []byte("\\u0026"), []byte("&"), -1)
-	}
-	return string(b[:]), nil
+	return strings.NewReplacer(`\u003c`, "<", `\u003e`, ">", `\u0026`, "&").Replace(v), nil
 }
 
 func expandPutRuleInput(d *schema.ResourceData, name string) *eventbridge.PutRuleInput {
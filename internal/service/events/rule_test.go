@@ -175,6 +175,10 @@ func TestRuleEventPatternJSONDecoder(t *testing.T) {
 			input:    `{"detail":{"count":[{"numeric":["\u0026",0,"\u0026",5]}]}}`,
 			expected: `{"detail":{"count":[{"numeric":["&",0,"&",5]}]}}`,
 		},
+		"numbers": {
+			input:    `{"source":["aws.ec2"], "detail":{"count":[{"numeric":[">",1.0,"<",5e2]}]}}`,
+			expected: `{"detail":{"count":[{"numeric":[">",1,"<",500]}]},"source":["aws.ec2"]}`,
+		},
 	}
 
 	for name, test := range tests {
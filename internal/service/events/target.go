@@ -425,7 +425,7 @@ func resourceTarget() *schema.Resource {
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNOf("iam", "role/*"),
 			},
 			names.AttrRule: {
 				Type:         schema.TypeString,
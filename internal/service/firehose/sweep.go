@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_kinesis_firehose_delivery_stream", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_kinesis_firehose_delivery_stream", &resource.Sweeper{
 		Name: "aws_kinesis_firehose_delivery_stream",
 		F:    sweepDeliveryStreams,
 	})
@@ -43,7 +43,7 @@ func sweepDeliveryStreams(region string) error {
 			arn := arn.ARN{
 				Partition: client.Partition,
 				Service:   "firehose",
-				Region:    client.Region,
+				Region:    client.Region(ctx),
 				AccountID: client.AccountID,
 				Resource:  fmt.Sprintf("deliverystream/%s", name),
 			}.String()
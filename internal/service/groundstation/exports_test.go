@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+// Exports for use in tests only.
+var (
+	ResourceConfig                = newConfigResource
+	ResourceDataflowEndpointGroup = newDataflowEndpointGroupResource
+	ResourceMissionProfile        = newMissionProfileResource
+
+	FindConfigByTwoPartKey        = findConfigByTwoPartKey
+	FindDataflowEndpointGroupByID = findDataflowEndpointGroupByID
+	FindMissionProfileByID        = findMissionProfileByID
+)
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_groundstation_ground_stations", name="Ground Stations")
+func newGroundStationsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &groundStationsDataSource{}, nil
+}
+
+type groundStationsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*groundStationsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_groundstation_ground_stations"
+}
+
+func (d *groundStationsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ground_stations": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[groundStationModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[groundStationModel](ctx),
+			},
+			names.AttrID: framework.IDAttribute(),
+			"satellite_id": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *groundStationsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data groundStationsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().GroundStationClient(ctx)
+
+	input := groundstation.ListGroundStationsInput{
+		SatelliteId: fwflex.StringFromFramework(ctx, data.SatelliteID),
+	}
+	groundStations, err := findGroundStations(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Ground Stations (%s)", d.Meta().Region(ctx)), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, groundStations, &data.GroundStations)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, d.Meta().Region(ctx))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findGroundStations(ctx context.Context, conn *groundstation.Client, input *groundstation.ListGroundStationsInput) ([]awstypes.GroundStationData, error) {
+	var output []awstypes.GroundStationData
+
+	pages := groundstation.NewListGroundStationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.GroundStationList...)
+	}
+
+	return output, nil
+}
+
+type groundStationsDataSourceModel struct {
+	GroundStations fwtypes.ListNestedObjectValueOf[groundStationModel] `tfsdk:"ground_stations"`
+	ID             types.String                                        `tfsdk:"id"`
+	SatelliteID    types.String                                        `tfsdk:"satellite_id"`
+}
+
+type groundStationModel struct {
+	GroundStationID   types.String `tfsdk:"ground_station_id"`
+	GroundStationName types.String `tfsdk:"ground_station_name"`
+	Region            types.String `tfsdk:"region"`
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_groundstation_config", sweepConfigs,
+		"aws_groundstation_mission_profile",
+	)
+
+	awsv2.Register("aws_groundstation_dataflow_endpoint_group", sweepDataflowEndpointGroups,
+		"aws_groundstation_config",
+	)
+
+	awsv2.Register("aws_groundstation_mission_profile", sweepMissionProfiles)
+}
+
+func sweepConfigs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.GroundStationClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := groundstation.NewListConfigsPaginator(conn, &groundstation.ListConfigsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, config := range page.ConfigList {
+			configID, configType := aws.ToString(config.ConfigId), string(config.ConfigType)
+			id, err := intflex.FlattenResourceId([]string{configID, configType}, configResourceIDPartCount, false)
+			if err != nil {
+				return nil, err
+			}
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newConfigResource, client,
+				framework.NewAttribute(names.AttrID, id),
+				framework.NewAttribute("config_id", configID),
+				framework.NewAttribute("config_type", configType)))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepDataflowEndpointGroups(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.GroundStationClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := groundstation.NewListDataflowEndpointGroupsPaginator(conn, &groundstation.ListDataflowEndpointGroupsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range page.DataflowEndpointGroupList {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newDataflowEndpointGroupResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(group.DataflowEndpointGroupId))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepMissionProfiles(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.GroundStationClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := groundstation.NewListMissionProfilesPaginator(conn, &groundstation.ListMissionProfilesInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, missionProfile := range page.MissionProfileList {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newMissionProfileResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(missionProfile.MissionProfileId))))
+		}
+	}
+
+	return sweepResources, nil
+}
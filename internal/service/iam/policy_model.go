@@ -82,6 +82,176 @@ func (s *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
 	}
 }
 
+// UnmarshalPolicyDoc parses a JSON IAM policy document.
+// Unlike json.Unmarshal it accepts a single statement object as the value of the document's `Statement` element.
+func UnmarshalPolicyDoc(s string) (*IAMPolicyDoc, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(s), &raw); err != nil {
+		return nil, err
+	}
+
+	if v, ok := raw["Statement"]; ok {
+		var statement map[string]any
+		if err := json.Unmarshal(v, &statement); err == nil {
+			raw["Statement"] = append(append([]byte("["), v...), ']')
+		}
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &IAMPolicyDoc{}
+	if err := json.Unmarshal(b, doc); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// Normalize rewrites the policy document in a canonical form:
+// action, resource and principal lists are de-duplicated and sorted, principals of the same type are combined
+// and single-element lists are collapsed to a string.
+// The canonical form matches that generated by the aws_iam_policy_document data source.
+func (s *IAMPolicyDoc) Normalize() error {
+	for _, statement := range s.Statements {
+		if statement == nil {
+			continue
+		}
+
+		var err error
+		if statement.Actions, err = policyNormalizeStringList(statement.Actions); err != nil {
+			return fmt.Errorf("Action: %w", err)
+		}
+		if statement.NotActions, err = policyNormalizeStringList(statement.NotActions); err != nil {
+			return fmt.Errorf("NotAction: %w", err)
+		}
+		if statement.Resources, err = policyNormalizeStringList(statement.Resources); err != nil {
+			return fmt.Errorf("Resource: %w", err)
+		}
+		if statement.NotResources, err = policyNormalizeStringList(statement.NotResources); err != nil {
+			return fmt.Errorf("NotResource: %w", err)
+		}
+		if statement.Principals, err = statement.Principals.normalize(); err != nil {
+			return fmt.Errorf("Principal: %w", err)
+		}
+		if statement.NotPrincipals, err = statement.NotPrincipals.normalize(); err != nil {
+			return fmt.Errorf("NotPrincipal: %w", err)
+		}
+		if statement.Conditions, err = statement.Conditions.normalize(); err != nil {
+			return fmt.Errorf("Condition: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// policyStringList returns the string or list of strings value of a policy element as a slice of strings.
+func policyStringList(v interface{}) ([]string, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case []string:
+		return v, nil
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, v := range v {
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("unsupported data type %T", v)
+			}
+			values = append(values, s)
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %T", v)
+	}
+}
+
+// policyNormalizeStringList de-duplicates and sorts the string or list of strings value of a policy element.
+func policyNormalizeStringList(v interface{}) (interface{}, error) {
+	values, err := policyStringList(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	return policyDecodeConfigStringList(policyUniqueStrings(values)), nil
+}
+
+func policyUniqueStrings(values []string) []interface{} {
+	seen := make(map[string]struct{}, len(values))
+	unique := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		unique = append(unique, v)
+	}
+
+	return unique
+}
+
+func (ps IAMPolicyStatementPrincipalSet) normalize() (IAMPolicyStatementPrincipalSet, error) {
+	if len(ps) == 0 {
+		return nil, nil
+	}
+
+	identifiers := make(map[string][]string)
+	for _, p := range ps {
+		values, err := policyStringList(p.Identifiers)
+		if err != nil {
+			return nil, err
+		}
+		identifiers[p.Type] = append(identifiers[p.Type], values...)
+	}
+
+	out := make(IAMPolicyStatementPrincipalSet, 0, len(identifiers))
+	for typ, values := range identifiers {
+		out = append(out, IAMPolicyStatementPrincipal{
+			Type:        typ,
+			Identifiers: policyDecodeConfigStringList(policyUniqueStrings(values)),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Type < out[j].Type
+	})
+
+	return out, nil
+}
+
+func (cs IAMPolicyStatementConditionSet) normalize() (IAMPolicyStatementConditionSet, error) {
+	if len(cs) == 0 {
+		return nil, nil
+	}
+
+	out := make(IAMPolicyStatementConditionSet, 0, len(cs))
+	for _, c := range cs {
+		values, err := policyStringList(c.Values)
+		if err != nil {
+			return nil, err
+		}
+		// Order matters with condition values so they are not sorted.
+		c.Values = values
+		out = append(out, c)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Test != out[j].Test {
+			return out[i].Test < out[j].Test
+		}
+		return out[i].Variable < out[j].Variable
+	})
+
+	return out, nil
+}
+
 func (ps IAMPolicyStatementPrincipalSet) MarshalJSON() ([]byte, error) {
 	raw := map[string]interface{}{}
 
@@ -220,6 +390,8 @@ func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: []string{var_values}})
 			case bool:
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: strconv.FormatBool(var_values)})
+			case float64:
+				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: strconv.FormatFloat(var_values, 'f', -1, 64)})
 			case []interface{}:
 				values := []string{}
 				for _, v := range var_values {
@@ -428,3 +428,64 @@ func TestPolicyUnmarshalServicePrincipalOrder(t *testing.T) {
 		t.Fatalf("should be equal, but was:\n%#v\nVS\n%#v\n", data1, data2)
 	}
 }
+
+func TestIAMPolicyDocNormalize(t *testing.T) { // nosemgrep:ci.iam-in-func-name
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy  string
+		want    string
+		wantErr bool
+	}{
+		"single statement object": {
+			policy: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+			want:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		"duplicate and unsorted lists": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject","s3:GetObject"],"Resource":["arn:aws:s3:::b","arn:aws:s3:::a"]}]}`,
+			want:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":["arn:aws:s3:::b","arn:aws:s3:::a"]}]}`,
+		},
+		"single element list": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["*"]}]}`,
+			want:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		"principals": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Service":["lambda.amazonaws.com","lambda.amazonaws.com"],"AWS":"arn:aws:iam::123456789012:root"}}]}`,
+			want:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":"arn:aws:iam::123456789012:root","Service":"lambda.amazonaws.com"}}]}`,
+		},
+		"numeric condition": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"NumericLessThanEquals":{"s3:max-keys":10}}}]}`,
+			want:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"NumericLessThanEquals":{"s3:max-keys":"10"}}}]}`,
+		},
+		"invalid JSON": {
+			policy:  `{`,
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := tfiam.UnmarshalPolicyDoc(tc.policy)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("UnmarshalPolicyDoc() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if err := doc.Normalize(); err != nil {
+				t.Fatalf("Normalize() error = %v", err)
+			}
+
+			got, err := json.Marshal(doc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("Normalize() = %s, want %s", string(got), tc.want)
+			}
+		})
+	}
+}
@@ -166,6 +166,14 @@ func resourceEventSourceMapping() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				ExactlyOneOf: []string{"event_source_arn", "self_managed_event_source"},
+				ValidateFunc: validation.Any(
+					verify.ValidARNOf("dynamodb", "table/*/stream/*"),
+					verify.ValidARNOf("kafka", "cluster/*"),
+					verify.ValidARNOf("kinesis", "stream/*"),
+					verify.ValidARNOf("mq", "broker:*"),
+					verify.ValidARNOf("rds", "cluster:*"),
+					verify.ValidARNOf("sqs"),
+				),
 			},
 			"filter_criteria": {
 				Type:     schema.TypeList,
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package neptunegraph
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_neptunegraph_graph", sweepGraphs,
+		"aws_neptunegraph_private_graph_endpoint",
+	)
+
+	awsv2.Register("aws_neptunegraph_graph_snapshot", sweepGraphSnapshots)
+
+	awsv2.Register("aws_neptunegraph_private_graph_endpoint", sweepPrivateGraphEndpoints)
+}
+
+func sweepGraphs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.NeptuneGraphClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := neptunegraph.NewListGraphsPaginator(conn, &neptunegraph.ListGraphsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, graph := range page.Graphs {
+			id := aws.ToString(graph.Id)
+
+			if v := aws.ToBool(graph.DeletionProtection); v {
+				log.Printf("[INFO] Skipping Neptune Analytics Graph %s: DeletionProtection=%t", id, v)
+				continue
+			}
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newGraphResource, client,
+				framework.NewAttribute(names.AttrID, id)))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepGraphSnapshots(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.NeptuneGraphClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := neptunegraph.NewListGraphSnapshotsPaginator(conn, &neptunegraph.ListGraphSnapshotsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, snapshot := range page.GraphSnapshots {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newGraphSnapshotResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(snapshot.Id))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepPrivateGraphEndpoints(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.NeptuneGraphClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := neptunegraph.NewListGraphsPaginator(conn, &neptunegraph.ListGraphsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, graph := range page.Graphs {
+			graphID := aws.ToString(graph.Id)
+
+			pages := neptunegraph.NewListPrivateGraphEndpointsPaginator(conn, &neptunegraph.ListPrivateGraphEndpointsInput{
+				GraphIdentifier: aws.String(graphID),
+			})
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, endpoint := range page.PrivateGraphEndpoints {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newPrivateGraphEndpointResource, client,
+						framework.NewAttribute("graph_id", graphID),
+						framework.NewAttribute(names.AttrVPCID, aws.ToString(endpoint.VpcId))))
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
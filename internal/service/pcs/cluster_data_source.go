@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_pcs_cluster", name="Cluster")
+func newClusterDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &clusterDataSource{}, nil
+}
+
+type clusterDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*clusterDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_pcs_cluster"
+}
+
+func (d *clusterDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"endpoints": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[endpointModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[endpointModel](ctx),
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"networking": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[networkingModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[networkingModel](ctx),
+			},
+			"scheduler": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[schedulerModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[schedulerModel](ctx),
+			},
+			names.AttrSize: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Size](),
+				Computed:   true,
+			},
+			"slurm_configuration": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[clusterSlurmConfigurationDataSourceModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[clusterSlurmConfigurationDataSourceModel](ctx),
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ClusterStatus](),
+				Computed:   true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *clusterDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data clusterDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().PCSClient(ctx)
+	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
+
+	name := data.Name.ValueString()
+	output, err := findClusterByID(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCS Cluster (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tags, err := listTags(ctx, conn, data.ARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing tags for PCS Cluster (%s)", name), err.Error())
+
+		return
+	}
+
+	data.Tags = tftags.FlattenStringValueMap(ctx, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type clusterDataSourceModel struct {
+	ARN                types.String                                                              `tfsdk:"arn"`
+	Endpoints          fwtypes.ListNestedObjectValueOf[endpointModel]                            `tfsdk:"endpoints"`
+	ID                 types.String                                                              `tfsdk:"id"`
+	Name               types.String                                                              `tfsdk:"name"`
+	Networking         fwtypes.ListNestedObjectValueOf[networkingModel]                          `tfsdk:"networking"`
+	Scheduler          fwtypes.ListNestedObjectValueOf[schedulerModel]                           `tfsdk:"scheduler"`
+	Size               fwtypes.StringEnum[awstypes.Size]                                         `tfsdk:"size"`
+	SlurmConfiguration fwtypes.ListNestedObjectValueOf[clusterSlurmConfigurationDataSourceModel] `tfsdk:"slurm_configuration"`
+	Status             fwtypes.StringEnum[awstypes.ClusterStatus]                                `tfsdk:"status"`
+	Tags               tftags.Map                                                                `tfsdk:"tags"`
+}
+
+type clusterSlurmConfigurationDataSourceModel struct {
+	AuthKey                    fwtypes.ListNestedObjectValueOf[slurmAuthKeyModel]       `tfsdk:"auth_key"`
+	ScaleDownIdleTimeInSeconds types.Int32                                              `tfsdk:"scale_down_idle_time_in_seconds"`
+	SlurmCustomSettings        fwtypes.ListNestedObjectValueOf[slurmCustomSettingModel] `tfsdk:"slurm_custom_settings"`
+}
+
+type slurmAuthKeyModel struct {
+	SecretARN     types.String `tfsdk:"secret_arn"`
+	SecretVersion types.String `tfsdk:"secret_version"`
+}
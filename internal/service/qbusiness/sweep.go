@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_qbusiness_application", sweepApplications,
+		"aws_qbusiness_index",
+		"aws_qbusiness_plugin",
+		"aws_qbusiness_retriever",
+		"aws_qbusiness_web_experience",
+	)
+
+	awsv2.Register("aws_qbusiness_data_source", sweepDataSources)
+
+	awsv2.Register("aws_qbusiness_index", sweepIndices,
+		"aws_qbusiness_data_source",
+		"aws_qbusiness_retriever",
+	)
+
+	awsv2.Register("aws_qbusiness_plugin", sweepPlugins)
+
+	awsv2.Register("aws_qbusiness_retriever", sweepRetrievers)
+
+	awsv2.Register("aws_qbusiness_web_experience", sweepWebExperiences)
+}
+
+func sweepApplications(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.QBusinessClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := qbusiness.NewListApplicationsPaginator(conn, &qbusiness.ListApplicationsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, application := range page.Applications {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newApplicationResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(application.ApplicationId))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepDataSources(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.QBusinessClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := qbusiness.NewListApplicationsPaginator(conn, &qbusiness.ListApplicationsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, application := range page.Applications {
+			applicationID := aws.ToString(application.ApplicationId)
+
+			pages := qbusiness.NewListIndicesPaginator(conn, &qbusiness.ListIndicesInput{
+				ApplicationId: aws.String(applicationID),
+			})
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, index := range page.Indices {
+					indexID := aws.ToString(index.IndexId)
+
+					pages := qbusiness.NewListDataSourcesPaginator(conn, &qbusiness.ListDataSourcesInput{
+						ApplicationId: aws.String(applicationID),
+						IndexId:       aws.String(indexID),
+					})
+					for pages.HasMorePages() {
+						page, err := pages.NextPage(ctx)
+						if err != nil {
+							return nil, err
+						}
+
+						for _, dataSource := range page.DataSources {
+							sweepResources = append(sweepResources, framework.NewSweepResource(newDataSourceResource, client,
+								framework.NewAttribute(names.AttrApplicationID, applicationID),
+								framework.NewAttribute("index_id", indexID),
+								framework.NewAttribute("data_source_id", aws.ToString(dataSource.DataSourceId))))
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepIndices(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.QBusinessClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := qbusiness.NewListApplicationsPaginator(conn, &qbusiness.ListApplicationsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, application := range page.Applications {
+			applicationID := aws.ToString(application.ApplicationId)
+
+			pages := qbusiness.NewListIndicesPaginator(conn, &qbusiness.ListIndicesInput{
+				ApplicationId: aws.String(applicationID),
+			})
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, index := range page.Indices {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newIndexResource, client,
+						framework.NewAttribute(names.AttrApplicationID, applicationID),
+						framework.NewAttribute("index_id", aws.ToString(index.IndexId))))
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepPlugins(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.QBusinessClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := qbusiness.NewListApplicationsPaginator(conn, &qbusiness.ListApplicationsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, application := range page.Applications {
+			applicationID := aws.ToString(application.ApplicationId)
+
+			pages := qbusiness.NewListPluginsPaginator(conn, &qbusiness.ListPluginsInput{
+				ApplicationId: aws.String(applicationID),
+			})
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, plugin := range page.Plugins {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newPluginResource, client,
+						framework.NewAttribute(names.AttrApplicationID, applicationID),
+						framework.NewAttribute("plugin_id", aws.ToString(plugin.PluginId))))
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepRetrievers(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.QBusinessClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := qbusiness.NewListApplicationsPaginator(conn, &qbusiness.ListApplicationsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, application := range page.Applications {
+			applicationID := aws.ToString(application.ApplicationId)
+
+			pages := qbusiness.NewListRetrieversPaginator(conn, &qbusiness.ListRetrieversInput{
+				ApplicationId: aws.String(applicationID),
+			})
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, retriever := range page.Retrievers {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newRetrieverResource, client,
+						framework.NewAttribute(names.AttrApplicationID, applicationID),
+						framework.NewAttribute("retriever_id", aws.ToString(retriever.RetrieverId))))
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepWebExperiences(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.QBusinessClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := qbusiness.NewListApplicationsPaginator(conn, &qbusiness.ListApplicationsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, application := range page.Applications {
+			applicationID := aws.ToString(application.ApplicationId)
+
+			pages := qbusiness.NewListWebExperiencesPaginator(conn, &qbusiness.ListWebExperiencesInput{
+				ApplicationId: aws.String(applicationID),
+			})
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, webExperience := range page.WebExperiences {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newWebExperienceResource, client,
+						framework.NewAttribute(names.AttrApplicationID, applicationID),
+						framework.NewAttribute("web_experience_id", aws.ToString(webExperience.WebExperienceId))))
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
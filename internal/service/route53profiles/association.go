@@ -150,7 +150,7 @@ func (r *resourceAssociation) Create(ctx context.Context, req resource.CreateReq
 	associationArn := arn.ARN{
 		Partition: r.Meta().Partition,
 		Service:   "route53profiles",
-		Region:    r.Meta().Region,
+		Region:    r.Meta().Region(ctx),
 		AccountID: r.Meta().AccountID,
 		Resource:  fmt.Sprintf("profile-association/%s", aws.ToString(out.ProfileAssociation.Id)),
 	}.String()
@@ -200,7 +200,7 @@ func (r *resourceAssociation) Read(ctx context.Context, req resource.ReadRequest
 	associationArn := arn.ARN{
 		Partition: r.Meta().Partition,
 		Service:   "route53profiles",
-		Region:    r.Meta().Region,
+		Region:    r.Meta().Region(ctx),
 		AccountID: r.Meta().AccountID,
 		Resource:  fmt.Sprintf("profile-association/%s", aws.ToString(out.Id)),
 	}.String()
@@ -36,7 +36,7 @@ func TestAccSESDomainIdentity_basic(t *testing.T) {
 				Config: testAccDomainIdentityConfig_basic(domain),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDomainIdentityExists(ctx, resourceName),
-					testAccCheckDomainIdentityARN("aws_ses_domain_identity.test", domain),
+					testAccCheckDomainIdentityARN(ctx, "aws_ses_domain_identity.test", domain),
 				),
 			},
 		},
@@ -127,7 +127,7 @@ func testAccCheckDomainIdentityExists(ctx context.Context, n string) resource.Te
 	}
 }
 
-func testAccCheckDomainIdentityARN(n string, domain string) resource.TestCheckFunc {
+func testAccCheckDomainIdentityARN(ctx context.Context, n string, domain string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs := s.RootModule().Resources[n]
 		awsClient := acctest.Provider.Meta().(*conns.AWSClient)
@@ -135,7 +135,7 @@ func testAccCheckDomainIdentityARN(n string, domain string) resource.TestCheckFu
 		expected := arn.ARN{
 			AccountID: awsClient.AccountID,
 			Partition: awsClient.Partition,
-			Region:    awsClient.Region,
+			Region:    awsClient.Region(ctx),
 			Resource:  fmt.Sprintf("identity/%s", strings.TrimSuffix(domain, ".")),
 			Service:   "ses",
 		}
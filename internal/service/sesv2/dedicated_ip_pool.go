@@ -111,7 +111,7 @@ func resourceDedicatedIPPoolRead(ctx context.Context, d *schema.ResourceData, me
 	poolName := aws.ToString(out.PoolName)
 	d.Set("pool_name", poolName)
 	d.Set("scaling_mode", string(out.ScalingMode))
-	d.Set(names.AttrARN, dedicatedIPPoolARN(meta, poolName))
+	d.Set(names.AttrARN, dedicatedIPPoolARN(ctx, meta, poolName))
 
 	return diags
 }
@@ -170,11 +170,11 @@ func findDedicatedIPPool(ctx context.Context, conn *sesv2.Client, input *sesv2.G
 	return output.DedicatedIpPool, nil
 }
 
-func dedicatedIPPoolARN(meta interface{}, poolName string) string {
+func dedicatedIPPoolARN(ctx context.Context, meta interface{}, poolName string) string {
 	return arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "ses",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).Region(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("dedicated-ip-pool/%s", poolName),
 	}.String()
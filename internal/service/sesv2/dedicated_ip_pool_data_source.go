@@ -79,7 +79,7 @@ func dataSourceDedicatedIPPoolRead(ctx context.Context, d *schema.ResourceData,
 	poolName := aws.ToString(out.PoolName)
 	d.SetId(poolName)
 	d.Set("scaling_mode", out.ScalingMode)
-	d.Set(names.AttrARN, dedicatedIPPoolARN(meta, poolName))
+	d.Set(names.AttrARN, dedicatedIPPoolARN(ctx, meta, poolName))
 
 	outIP, err := findDedicatedIPsByPoolName(ctx, conn, poolName)
 	if err != nil {
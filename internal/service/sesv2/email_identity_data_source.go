@@ -103,7 +103,7 @@ func dataSourceEmailIdentityRead(ctx context.Context, d *schema.ResourceData, me
 		return create.AppendDiagError(diags, names.SESV2, create.ErrActionReading, dsNameEmailIdentity, name, err)
 	}
 
-	arn := emailIdentityARN(meta, name)
+	arn := emailIdentityARN(ctx, meta, name)
 
 	d.SetId(name)
 	d.Set(names.AttrARN, arn)
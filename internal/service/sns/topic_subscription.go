@@ -128,13 +128,13 @@ var (
 		"subscription_role_arn": {
 			Type:         schema.TypeString,
 			Optional:     true,
-			ValidateFunc: verify.ValidARN,
+			ValidateFunc: verify.ValidARNOf("iam", "role/*"),
 		},
 		names.AttrTopicARN: {
 			Type:         schema.TypeString,
 			Required:     true,
 			ForceNew:     true,
-			ValidateFunc: verify.ValidARN,
+			ValidateFunc: verify.ValidARNOf("sns"),
 		},
 	}
 
@@ -330,7 +330,7 @@ func resourceDocumentRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	documentType, name := doc.DocumentType, aws.ToString(doc.Name)
-	d.Set(names.AttrARN, documentARN(meta.(*conns.AWSClient), documentType, name))
+	d.Set(names.AttrARN, documentARN(ctx, meta.(*conns.AWSClient), documentType, name))
 	d.Set(names.AttrCreatedDate, aws.ToTime(doc.CreatedDate).Format(time.RFC3339))
 	d.Set("default_version", doc.DefaultVersion)
 	d.Set(names.AttrDescription, doc.Description)
@@ -716,11 +716,11 @@ func flattenDocumentParameters(apiObjects []awstypes.DocumentParameter) []interf
 	return tfList
 }
 
-func documentARN(c *conns.AWSClient, documentType awstypes.DocumentType, name string) string {
+func documentARN(ctx context.Context, c *conns.AWSClient, documentType awstypes.DocumentType, name string) string {
 	arn := arn.ARN{
 		Partition: c.Partition,
 		Service:   "ssm",
-		Region:    c.Region,
+		Region:    c.Region(ctx),
 		AccountID: c.AccountID,
 	}
 
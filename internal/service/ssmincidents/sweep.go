@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ssmincidents_replication_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssmincidents_replication_set", &resource.Sweeper{
 		Name: "aws_ssmincidents_replication_set",
 		F:    sweepReplicationSets,
 	})
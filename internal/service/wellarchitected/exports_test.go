@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+// Exports for use in tests only.
+var (
+	ResourceLens      = newLensResource
+	ResourceMilestone = newMilestoneResource
+	ResourceProfile   = newProfileResource
+	ResourceWorkload  = newWorkloadResource
+
+	FindLensByARN             = findLensByARN
+	FindMilestoneByTwoPartKey = findMilestoneByTwoPartKey
+	FindProfileByARN          = findProfileByARN
+	FindWorkloadByID          = findWorkloadByID
+)
@@ -0,0 +1,393 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_lens", name="Lens")
+// @Tags(identifierAttribute="arn")
+func newLensResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &lensResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type lensResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (*lensResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_wellarchitected_lens"
+}
+
+func (r *lensResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			"is_major_version": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"json_string": schema.StringAttribute{
+				CustomType: fwtypes.JSONDocumentType[lensJSONNormalizers](),
+				Required:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrOwner: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrVersion: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *lensResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data lensResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	input := wellarchitected.ImportLensInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		JSONString:         fwflex.StringFromFramework(ctx, data.JSONString),
+		Tags:               getTagsIn(ctx),
+	}
+
+	output, err := conn.ImportLens(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Well-Architected Lens", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.LensARN = fwflex.StringToFramework(ctx, output.LensArn)
+
+	lens, err := waitLensImported(ctx, conn, data.LensARN.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrARN), data.LensARN) // Set 'arn' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Well-Architected Lens (%s) create", data.LensARN.ValueString()), err.Error())
+
+		return
+	}
+
+	if !data.Version.IsNull() {
+		if err := createLensVersion(ctx, conn, &data); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrARN), data.LensARN) // Set 'arn' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("publishing Well-Architected Lens (%s) version (%s)", data.LensARN.ValueString(), data.Version.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, lens, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *lensResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data lensResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	output, err := findLensByARN(ctx, conn, data.LensARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens (%s)", data.LensARN.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Differences between the configured and exported lens JSON that aren't drift are suppressed by the attribute's semantic equality.
+	json, err := exportLens(ctx, conn, data.LensARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("exporting Well-Architected Lens (%s)", data.LensARN.ValueString()), err.Error())
+
+		return
+	}
+
+	data.JSONString = fwtypes.JSONDocumentValue[lensJSONNormalizers](json)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *lensResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new lensResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := new.LensARN.ValueString()
+
+	if !new.JSONString.Equal(old.JSONString) {
+		input := wellarchitected.ImportLensInput{
+			ClientRequestToken: aws.String(sdkid.UniqueId()),
+			JSONString:         fwflex.StringFromFramework(ctx, new.JSONString),
+			LensAlias:          aws.String(arn),
+		}
+
+		_, err := conn.ImportLens(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Well-Architected Lens (%s)", arn), err.Error())
+
+			return
+		}
+
+		if _, err := waitLensImported(ctx, conn, arn, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Well-Architected Lens (%s) update", arn), err.Error())
+
+			return
+		}
+	}
+
+	if !new.Version.IsNull() && (!new.JSONString.Equal(old.JSONString) || !new.Version.Equal(old.Version)) {
+		if err := createLensVersion(ctx, conn, &new); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("publishing Well-Architected Lens (%s) version (%s)", arn, new.Version.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	lens, err := findLensByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, lens, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *lensResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data lensResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	_, err := conn.DeleteLens(ctx, &wellarchitected.DeleteLensInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		LensAlias:          fwflex.StringFromFramework(ctx, data.LensARN),
+		LensStatus:         awstypes.LensStatusTypeAll,
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Lens (%s)", data.LensARN.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *lensResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrARN), request, response)
+}
+
+func (r *lensResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func createLensVersion(ctx context.Context, conn *wellarchitected.Client, data *lensResourceModel) error {
+	input := wellarchitected.CreateLensVersionInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()),
+		IsMajorVersion:     fwflex.BoolFromFramework(ctx, data.IsMajorVersion),
+		LensAlias:          fwflex.StringFromFramework(ctx, data.LensARN),
+		LensVersion:        fwflex.StringFromFramework(ctx, data.Version),
+	}
+
+	_, err := conn.CreateLensVersion(ctx, &input)
+
+	return err
+}
+
+func exportLens(ctx context.Context, conn *wellarchitected.Client, arn string) (string, error) {
+	input := &wellarchitected.ExportLensInput{
+		LensAlias: aws.String(arn),
+	}
+
+	output, err := conn.ExportLens(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.LensJSON == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.ToString(output.LensJSON), nil
+}
+
+// findLensByARN returns the summary of a custom lens owned by the caller.
+// Lenses that have been imported but not yet published are only visible via ListLenses.
+func findLensByARN(ctx context.Context, conn *wellarchitected.Client, arn string) (*awstypes.LensSummary, error) {
+	input := &wellarchitected.ListLensesInput{
+		LensStatus: awstypes.LensStatusTypeAll,
+		LensType:   awstypes.LensTypeCustomSelf,
+	}
+
+	pages := wellarchitected.NewListLensesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.LensSummaries {
+			if aws.ToString(v.LensArn) != arn {
+				continue
+			}
+
+			if status := v.LensStatus; status == awstypes.LensStatusDeleted {
+				return nil, &retry.NotFoundError{
+					Message:     string(status),
+					LastRequest: input,
+				}
+			}
+
+			return &v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func waitLensImported(ctx context.Context, conn *wellarchitected.Client, arn string, timeout time.Duration) (*awstypes.LensSummary, error) {
+	outputRaw, err := tfresource.RetryWhenNotFound(ctx, timeout, func() (interface{}, error) {
+		return findLensByARN(ctx, conn, arn)
+	})
+
+	if output, ok := outputRaw.(*awstypes.LensSummary); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// lensExportedFields are the lens JSON fields that are added by the service when a lens is exported.
+var lensExportedFields = []string{
+	"_version",
+}
+
+// lensJSONNormalizers normalizes lens JSON so that documents differing only in fields added by the service are equal.
+type lensJSONNormalizers struct{}
+
+func (lensJSONNormalizers) Normalizers() []tfjson.Normalizer {
+	return []tfjson.Normalizer{tfjson.NormalizeNumbers, tfjson.StripEmpty, tfjson.StripFields(lensExportedFields...)}
+}
+
+type lensResourceModel struct {
+	Description    types.String                              `tfsdk:"description"`
+	IsMajorVersion types.Bool                                `tfsdk:"is_major_version"`
+	JSONString     fwtypes.JSONDocument[lensJSONNormalizers] `tfsdk:"json_string"`
+	LensARN        types.String                              `tfsdk:"arn"`
+	LensName       types.String                              `tfsdk:"name"`
+	Owner          types.String                              `tfsdk:"owner"`
+	Tags           tftags.Map                                `tfsdk:"tags"`
+	TagsAll        tftags.Map                                `tfsdk:"tags_all"`
+	Timeouts       timeouts.Value                            `tfsdk:"timeouts"`
+	Version        types.String                              `tfsdk:"version"`
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_workspacesweb_browser_settings", sweepBrowserSettings, "aws_workspacesweb_portal")
+	awsv2.Register("aws_workspacesweb_ip_access_settings", sweepIPAccessSettings, "aws_workspacesweb_portal")
+	awsv2.Register("aws_workspacesweb_network_settings", sweepNetworkSettings, "aws_workspacesweb_portal")
+	awsv2.Register("aws_workspacesweb_trust_store", sweepTrustStores, "aws_workspacesweb_portal")
+	awsv2.Register("aws_workspacesweb_user_access_logging_settings", sweepUserAccessLoggingSettings, "aws_workspacesweb_portal")
+	awsv2.Register("aws_workspacesweb_user_settings", sweepUserSettings, "aws_workspacesweb_portal")
+	awsv2.Register("aws_workspacesweb_portal", sweepPortals)
+}
+
+func sweepBrowserSettings(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.WorkSpacesWebClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := workspacesweb.NewListBrowserSettingsPaginator(conn, &workspacesweb.ListBrowserSettingsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.BrowserSettings {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newBrowserSettingsResource, client,
+				framework.NewAttribute("browser_settings_arn", aws.ToString(v.BrowserSettingsArn))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepIPAccessSettings(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.WorkSpacesWebClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := workspacesweb.NewListIpAccessSettingsPaginator(conn, &workspacesweb.ListIpAccessSettingsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.IpAccessSettings {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newIPAccessSettingsResource, client,
+				framework.NewAttribute("ip_access_settings_arn", aws.ToString(v.IpAccessSettingsArn))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepNetworkSettings(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.WorkSpacesWebClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := workspacesweb.NewListNetworkSettingsPaginator(conn, &workspacesweb.ListNetworkSettingsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.NetworkSettings {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newNetworkSettingsResource, client,
+				framework.NewAttribute("network_settings_arn", aws.ToString(v.NetworkSettingsArn))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepPortals(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.WorkSpacesWebClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := workspacesweb.NewListPortalsPaginator(conn, &workspacesweb.ListPortalsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Portals {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newPortalResource, client,
+				framework.NewAttribute("portal_arn", aws.ToString(v.PortalArn))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepTrustStores(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.WorkSpacesWebClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := workspacesweb.NewListTrustStoresPaginator(conn, &workspacesweb.ListTrustStoresInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.TrustStores {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newTrustStoreResource, client,
+				framework.NewAttribute("trust_store_arn", aws.ToString(v.TrustStoreArn))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepUserAccessLoggingSettings(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.WorkSpacesWebClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := workspacesweb.NewListUserAccessLoggingSettingsPaginator(conn, &workspacesweb.ListUserAccessLoggingSettingsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.UserAccessLoggingSettings {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newUserAccessLoggingSettingsResource, client,
+				framework.NewAttribute("user_access_logging_settings_arn", aws.ToString(v.UserAccessLoggingSettingsArn))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepUserSettings(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.WorkSpacesWebClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := workspacesweb.NewListUserSettingsPaginator(conn, &workspacesweb.ListUserSettingsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.UserSettings {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newUserSettingsResource, client,
+				framework.NewAttribute("user_settings_arn", aws.ToString(v.UserSettingsArn))))
+		}
+	}
+
+	return sweepResources, nil
+}
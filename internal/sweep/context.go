@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
 )
 
+type regionKey struct{}
+
 func Context(region string) context.Context {
 	ctx := context.Background()
 
@@ -17,5 +19,14 @@ func Context(region string) context.Context {
 
 	ctx = log.Logger(ctx, "sweeper", region)
 
+	ctx = context.WithValue(ctx, regionKey{}, region)
+
 	return ctx
 }
+
+// regionFromContext returns the Region a sweeper Context was created for.
+func regionFromContext(ctx context.Context) string {
+	region, _ := ctx.Value(regionKey{}).(string)
+
+	return region
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+// Importable is implemented by Sweepables that can be imported into Terraform.
+type Importable interface {
+	// ImportID returns the resource's type name, if known, and the ID with which it's imported.
+	// An empty ID is returned if the resource doesn't support import.
+	ImportID(ctx context.Context) (string, string, error)
+}
+
+// DiscoveredResource is an existing resource, found by a sweeper, that can be imported into Terraform.
+type DiscoveredResource struct {
+	Region   string
+	Type     string
+	ImportID string
+}
+
+// Discover runs the sweepers in dry-run mode in each of the specified Regions and returns the importable resources that they find.
+// Resources that can't be imported are skipped.
+func (r *Runner) Discover(regions []string) ([]DiscoveredResource, error) {
+	return r.discover(regions, registeredSweepers)
+}
+
+func (r *Runner) discover(regions []string, sweepers map[string]*resource.Sweeper) ([]DiscoveredResource, error) {
+	report := newDryRunReport()
+	var errs []error
+
+	if err := r.execute(regions, sweepers, report); err != nil {
+		if !r.AllowFailures {
+			return nil, err
+		}
+		errs = append(errs, err)
+	}
+
+	resourceTypes := sdkResourceTypes()
+	var resources []DiscoveredResource
+
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+		names := maps.Keys(report.sweepables[region])
+		slices.Sort(names)
+
+		ctx := Context(region)
+		for _, name := range names {
+			for _, sweepable := range report.sweepables[region][name] {
+				v, ok := sweepable.(Importable)
+				if !ok {
+					log.Printf("[WARN] Skipping %s (%s) in region (%s): import not supported", name, describeSweepable(sweepable), region)
+					continue
+				}
+
+				typeName, id, err := v.ImportID(ctx)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("sweeper (%s) for region (%s): %w", name, region, err))
+					continue
+				}
+
+				if typeName == "" {
+					// Plugin SDK resources are swept by a sweeper named for the resource type.
+					if _, ok := resourceTypes[name]; !ok && resourceTypes != nil {
+						log.Printf("[WARN] Skipping %s (%s) in region (%s): unknown resource type", name, describeSweepable(sweepable), region)
+						continue
+					}
+					typeName = name
+				}
+
+				if id == "" {
+					log.Printf("[WARN] Skipping %s (%s) in region (%s): import not supported", typeName, describeSweepable(sweepable), region)
+					continue
+				}
+
+				resources = append(resources, DiscoveredResource{
+					Region:   region,
+					Type:     typeName,
+					ImportID: id,
+				})
+			}
+		}
+	}
+
+	return resources, errors.Join(errs...)
+}
+
+// sdkResourceTypes returns the type names of the Plugin SDK resources registered by the service packages.
+// nil is returned if no service packages are registered.
+func sdkResourceTypes() map[string]struct{} {
+	if len(ServicePackages) == 0 {
+		return nil
+	}
+
+	ctx := context.Background()
+	resourceTypes := make(map[string]struct{})
+	for _, sp := range ServicePackages {
+		for _, v := range sp.SDKResources(ctx) {
+			resourceTypes[v.TypeName] = struct{}{}
+		}
+	}
+
+	return resourceTypes
+}
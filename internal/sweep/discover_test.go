@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestRunnerDiscover(t *testing.T) { //nolint:paralleltest // Dry-run mode is process-wide.
+	// Don't check resource types against the registered service packages.
+	servicePackages := ServicePackages
+	ServicePackages = nil
+	t.Cleanup(func() {
+		ServicePackages = servicePackages
+	})
+
+	sweepers := map[string]*resource.Sweeper{
+		"aws_a": {
+			Name: "aws_a",
+			F: func(region string) error {
+				return SweepOrchestrator(Context(region), []Sweepable{
+					testImportable{id: region + "-a-1"},
+					testImportable{id: ""},
+					testSweepable("not-importable"),
+				})
+			},
+		},
+		"aws_b": {
+			Name: "aws_b",
+			F: func(region string) error {
+				return SweepOrchestrator(Context(region), []Sweepable{
+					testImportable{typeName: "aws_b_other", id: region + "-b-1"},
+					testImportable{err: errors.New("no import ID")},
+				})
+			},
+			Dependencies: []string{"aws_a"},
+		},
+	}
+	runner := &Runner{AllowFailures: true}
+
+	resources, err := runner.discover([]string{"us-west-2", "us-east-1"}, sweepers) //lintignore:AWSAT003
+
+	if err == nil {
+		t.Error("expected error, got none")
+	}
+
+	expected := []DiscoveredResource{
+		{Region: "us-west-2", Type: "aws_a", ImportID: "us-west-2-a-1"},       //lintignore:AWSAT003
+		{Region: "us-west-2", Type: "aws_b_other", ImportID: "us-west-2-b-1"}, //lintignore:AWSAT003
+		{Region: "us-east-1", Type: "aws_a", ImportID: "us-east-1-a-1"},       //lintignore:AWSAT003
+		{Region: "us-east-1", Type: "aws_b_other", ImportID: "us-east-1-b-1"}, //lintignore:AWSAT003
+	}
+	if diff := cmp.Diff(resources, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if dryRun != nil {
+		t.Error("dry-run mode not reset")
+	}
+}
+
+type testImportable struct {
+	typeName string
+	id       string
+	err      error
+}
+
+func (s testImportable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	return errors.New("deleted in discovery mode")
+}
+
+func (s testImportable) ImportID(context.Context) (string, string, error) {
+	return s.typeName, s.id, s.err
+}
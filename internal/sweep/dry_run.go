@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// dryRun is non-nil when sweepers are run in dry-run mode.
+var dryRun *dryRunReport
+
+// DryRunReport lists, per Region and sweeper, the resources that sweepers would delete.
+type DryRunReport map[string]map[string][]string
+
+type dryRunReport struct {
+	mu sync.Mutex
+	// current is the sweeper running in each Region.
+	// Sweepers are run serially in dry-run mode so that resources can be attributed to them.
+	current map[string]string
+	report  DryRunReport
+	// sweepables are the resources, per Region and sweeper, that sweepers would delete.
+	sweepables map[string]map[string][]Sweepable
+}
+
+func newDryRunReport() *dryRunReport {
+	return &dryRunReport{
+		current:    make(map[string]string),
+		report:     make(DryRunReport),
+		sweepables: make(map[string]map[string][]Sweepable),
+	}
+}
+
+func (r *dryRunReport) start(region, sweeper string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.current[region] = sweeper
+	if _, ok := r.report[region]; !ok {
+		r.report[region] = make(map[string][]string)
+	}
+	if _, ok := r.report[region][sweeper]; !ok {
+		r.report[region][sweeper] = make([]string, 0)
+	}
+}
+
+func (r *dryRunReport) add(ctx context.Context, items ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	region := regionFromContext(ctx)
+	sweeper := r.current[region]
+	if _, ok := r.report[region]; !ok {
+		r.report[region] = make(map[string][]string)
+	}
+	r.report[region][sweeper] = append(r.report[region][sweeper], items...)
+}
+
+func (r *dryRunReport) addSweepables(ctx context.Context, sweepables ...Sweepable) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	region := regionFromContext(ctx)
+	sweeper := r.current[region]
+	if _, ok := r.sweepables[region]; !ok {
+		r.sweepables[region] = make(map[string][]Sweepable)
+	}
+	r.sweepables[region][sweeper] = append(r.sweepables[region][sweeper], sweepables...)
+}
+
+// describeSweepable returns a human-readable description of the resource to be deleted.
+func describeSweepable(s Sweepable) string {
+	if v, ok := s.(fmt.Stringer); ok {
+		return v.String()
+	}
+
+	return fmt.Sprintf("%T", s)
+}
+
+// readOnlyOperationPrefixes are the prefixes of AWS API operation names that don't modify resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+}
+
+func isReadOnlyOperation(name string) bool {
+	return slices.ContainsFunc(readOnlyOperationPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(name, prefix)
+	})
+}
+
+// dryRunAPIOption is AWS SDK for Go v2 API client middleware that prevents sweepers which call
+// mutating AWS APIs directly, rather than via SweepOrchestrator, from modifying resources in dry-run mode.
+// Blocked calls are added to the dry-run report.
+func dryRunAPIOption(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("SweeperDryRun", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		if operation := awsmiddleware.GetOperationName(ctx); !isReadOnlyOperation(operation) {
+			call := fmt.Sprintf("%s %s", awsmiddleware.GetServiceID(ctx), operation)
+			if dryRun != nil {
+				dryRun.add(ctx, fmt.Sprintf("API call: %s", call))
+			}
+
+			return middleware.InitializeOutput{}, middleware.Metadata{}, fmt.Errorf("sweeper dry run: not calling %s", call)
+		}
+
+		return next.HandleInitialize(ctx, in)
+	}), middleware.After)
+}
@@ -5,10 +5,12 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awsretry "github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
@@ -48,6 +50,62 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 	}
 }
 
+func (sr *sweepResource) String() string {
+	attributes := make([]string, 0, len(sr.attributes))
+	for _, attr := range sr.attributes {
+		attributes = append(attributes, fmt.Sprintf("%s=%v", attr.path, attr.value))
+	}
+
+	return strings.Join(attributes, ", ")
+}
+
+// ImportID returns the resource's type name and the ID with which it's imported.
+// The import ID is the value of the sweeper attribute that the resource's ImportState method sets from it.
+func (sr *sweepResource) ImportID(ctx context.Context) (string, string, error) {
+	resource, err := sr.factory(ctx)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	typeName := resourceMetadata(ctx, resource).TypeName
+
+	v, ok := resource.(fwresource.ResourceWithImportState)
+	if !ok {
+		return typeName, "", nil
+	}
+
+	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
+
+	schemaResp := fwresource.SchemaResponse{}
+	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	for _, attr := range sr.attributes {
+		id, ok := attr.value.(string)
+		if !ok || id == "" {
+			continue
+		}
+
+		response := fwresource.ImportStateResponse{
+			State: tfsdk.State{
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				Schema: schemaResp.Schema,
+			},
+		}
+		v.ImportState(ctx, fwresource.ImportStateRequest{ID: id}, &response)
+
+		if response.Diagnostics.HasError() {
+			continue
+		}
+
+		if value, err := stringAttribute(response.State.Raw, attr.path); err == nil && value == id {
+			return typeName, id, nil
+		}
+	}
+
+	return typeName, "", fmt.Errorf("determining %s import ID from %s", typeName, sr)
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	resource, err := sr.factory(ctx)
 
@@ -78,6 +136,11 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 
 	tflog.Info(ctx, "Sweeping resource")
 
+	return deleteWithRetry(ctx, timeout, state, resource, optFns...)
+}
+
+// deleteWithRetry calls the resource's Delete method, retrying throttling errors.
+func deleteWithRetry(ctx context.Context, timeout time.Duration, state tfsdk.State, resource fwresource.Resource, optFns ...tfresource.OptionsFunc) error {
 	jitter := time.Duration(rand.Int63n(int64(1*time.Second))) - 1*time.Second/2
 	defaultOpts := []tfresource.OptionsFunc{
 		tfresource.WithMinPollInterval(2*time.Second + jitter),
@@ -85,7 +148,7 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	// Put defaults first so subsequent optFns will override them
 	optFns = append(defaultOpts, optFns...)
 
-	err = tfresource.Retry(ctx, timeout, func() *retry.RetryError {
+	err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
 		err := deleteResource(ctx, state, resource)
 
 		if err != nil {
@@ -118,6 +181,101 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+type importedSweepResource struct {
+	factory func(context.Context) (fwresource.ResourceWithConfigure, error)
+	meta    *conns.AWSClient
+	id      string
+	state   tfsdk.State
+}
+
+// NewImportedSweepResource returns a sweepable for the resource with the specified import ID, as read by the resource's Read method.
+// nil is returned if no such resource exists or if arn is not empty and the resource's `arn` attribute doesn't match it.
+func NewImportedSweepResource(ctx context.Context, factory func(context.Context) (fwresource.ResourceWithConfigure, error), id, arn string, meta *conns.AWSClient) (*importedSweepResource, error) {
+	resource, err := factory(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: meta}, &fwresource.ConfigureResponse{})
+
+	schemaResp := fwresource.SchemaResponse{}
+	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		Schema: schemaResp.Schema,
+	}
+
+	if v, ok := resource.(fwresource.ResourceWithImportState); ok {
+		response := fwresource.ImportStateResponse{State: state}
+		v.ImportState(ctx, fwresource.ImportStateRequest{ID: id}, &response)
+
+		if response.Diagnostics.HasError() {
+			return nil, fwdiag.DiagnosticsError(response.Diagnostics)
+		}
+		state = response.State
+	} else if d := state.SetAttribute(ctx, path.Root("id"), id); d.HasError() {
+		return nil, fwdiag.DiagnosticsError(d)
+	}
+
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	if response.Diagnostics.HasError() {
+		return nil, fwdiag.DiagnosticsError(response.Diagnostics)
+	}
+
+	if response.State.Raw.IsNull() {
+		return nil, nil
+	}
+
+	if arn != "" {
+		if v, err := stringAttribute(response.State.Raw, "arn"); err == nil && v != "" && v != arn {
+			return nil, nil
+		}
+	}
+
+	return &importedSweepResource{
+		factory: factory,
+		meta:    meta,
+		id:      id,
+		state:   response.State,
+	}, nil
+}
+
+func (sr *importedSweepResource) String() string {
+	return sr.id
+}
+
+// ImportID returns the resource's type name and the ID with which it was imported.
+func (sr *importedSweepResource) ImportID(ctx context.Context) (string, string, error) {
+	resource, err := sr.factory(ctx)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return resourceMetadata(ctx, resource).TypeName, sr.id, nil
+}
+
+func (sr *importedSweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	resource, err := sr.factory(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	ctx = tflog.SetField(ctx, "resource_type", resourceMetadata(ctx, resource).TypeName)
+	ctx = tflog.SetField(ctx, "id", sr.id)
+
+	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
+
+	tflog.Info(ctx, "Sweeping resource")
+
+	return deleteWithRetry(ctx, timeout, sr.state, resource, optFns...)
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
@@ -131,3 +289,24 @@ func resourceMetadata(ctx context.Context, resource fwresource.Resource) fwresou
 
 	return response
 }
+
+// stringAttribute returns the value of the named top-level string attribute.
+func stringAttribute(v tftypes.Value, name string) (string, error) {
+	raw, _, err := tftypes.WalkAttributePath(v, tftypes.NewAttributePath().WithAttributeName(name))
+
+	if err != nil {
+		return "", err
+	}
+
+	value, ok := raw.(tftypes.Value)
+	if !ok {
+		return "", fmt.Errorf("unexpected type %T", raw)
+	}
+
+	var s *string
+	if err := value.As(&s); err != nil {
+		return "", err
+	}
+
+	return aws.ToString(s), nil
+}
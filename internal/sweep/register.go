@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// registeredSweepers holds all sweepers registered via AddTestSweepers.
+// The Terraform Plugin Testing framework doesn't expose its own registry.
+var registeredSweepers = make(map[string]*resource.Sweeper)
+
+// AddTestSweepers registers a sweeper with both the sweeper runner and the Terraform Plugin Testing framework.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	registeredSweepers[name] = s
+
+	resource.AddTestSweepers(name, s)
+}
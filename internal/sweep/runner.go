@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+// TestMain runs the registered sweepers if the `-sweep` flag is set, otherwise the tests are run.
+// In addition to the Terraform Plugin Testing framework's sweeper flags, it supports:
+//
+//	-sweep-parallelism: Maximum number of sweepers to run concurrently in each Region. Defaults to 1.
+//	-sweep-dry-run: Enable to report the resources that sweepers would delete without deleting them.
+//	-sweep-tag: Sweep the resources bearing this tag (key=value, or key for any value) instead of running the registered sweepers.
+func TestMain(m interface {
+	Run() int
+}) {
+	// Flags are defined here rather than at package level as this package is linked into the provider binary.
+	parallelism := flag.Int("sweep-parallelism", 1, "Maximum number of Sweepers to run concurrently in each Region")
+	dryRun := flag.Bool("sweep-dry-run", false, "Enable to report the resources that Sweepers would delete without deleting them")
+	tag := flag.String("sweep-tag", "", "Sweep the resources bearing this tag (key=value) instead of running the registered Sweepers")
+	flag.Parse()
+
+	regions := flag.Lookup("sweep").Value.String()
+	if regions == "" {
+		resource.TestMain(m)
+		return
+	}
+
+	runner := &Runner{
+		AllowFailures: flag.Lookup("sweep-allow-failures").Value.String() == "true",
+		DryRun:        *dryRun,
+		Filter:        flag.Lookup("sweep-run").Value.String(),
+		Parallelism:   *parallelism,
+		Tag:           *tag,
+	}
+
+	if err := runner.Run(strings.Split(regions, ",")); err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+// Runner runs registered sweepers in dependency order.
+// Sweepers that don't depend on each other are run concurrently.
+type Runner struct {
+	// AllowFailures enables sweepers to continue to run after a failure.
+	AllowFailures bool
+	// DryRun enables reporting the resources that sweepers would delete without deleting them.
+	// Sweepers are run serially in dry-run mode.
+	DryRun bool
+	// Filter is a comma-separated list of sweeper names (or parts of names) to run, along with their dependencies.
+	// All sweepers are run if empty.
+	Filter string
+	// Parallelism is the maximum number of sweepers to run concurrently in each Region.
+	Parallelism int
+	// Tag is a tag, in key=value form, that selects the resources to sweep.
+	// If set, the resources bearing the tag are found with the Resource Groups Tagging API and deleted using their resource types' Delete functions
+	// instead of running the registered sweepers. Filter then selects resource types rather than sweepers.
+	// A tag without a value selects resources bearing the tag key with any value.
+	Tag string
+}
+
+// Run runs the sweepers in each of the specified Regions in turn.
+// The dependency graph is validated before any sweeper is run.
+func (r *Runner) Run(regions []string) error {
+	if r.Tag != "" {
+		return r.runTagged(regions)
+	}
+
+	return r.run(regions, registeredSweepers)
+}
+
+func (r *Runner) run(regions []string, sweepers map[string]*resource.Sweeper) error {
+	var report *dryRunReport
+	if r.DryRun {
+		report = newDryRunReport()
+	}
+
+	err := r.execute(regions, sweepers, report)
+
+	if report != nil {
+		if encodeErr := json.NewEncoder(os.Stdout).Encode(report.report); encodeErr != nil {
+			err = errors.Join(err, fmt.Errorf("writing dry-run report: %w", encodeErr))
+		}
+	}
+
+	return err
+}
+
+// execute runs the sweepers in each of the specified Regions in turn.
+// The sweepers are run in dry-run mode, recording the resources they would delete in report, if it's not nil.
+func (r *Runner) execute(regions []string, sweepers map[string]*resource.Sweeper, report *dryRunReport) error {
+	g, err := sweeperGraph(sweepers)
+	if err != nil {
+		return err
+	}
+
+	names, err := filterSweepers(g, r.Filter)
+	if err != nil {
+		return err
+	}
+
+	parallelism := max(r.Parallelism, 1)
+	if report != nil {
+		dryRun = report
+		defer func() {
+			dryRun = nil
+		}()
+		parallelism = 1
+	}
+
+	var errs []error
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+
+		start := time.Now()
+		log.Printf("[DEBUG] Running Sweepers for region (%s)", region)
+
+		results := runSweepers(g, sweepers, names, region, parallelism, r.AllowFailures)
+
+		log.Printf("Completed Sweepers for region (%s) in %s", region, time.Since(start))
+
+		for _, name := range names {
+			err, ok := results[name]
+			switch {
+			case !ok:
+				log.Printf("\t- %s: not run", name)
+			case err != nil:
+				log.Printf("\t- %s: %s", name, err)
+				errs = append(errs, fmt.Errorf("sweeper (%s) for region (%s) failed: %w", name, region, err))
+			default:
+				log.Printf("\t- %s", name)
+			}
+		}
+
+		if len(errs) > 0 && !r.AllowFailures {
+			break
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sweeperGraph returns the dependency graph of the specified sweepers.
+// An error is returned if a sweeper depends on an unregistered sweeper or there is a dependency cycle.
+func sweeperGraph(sweepers map[string]*resource.Sweeper) (*depgraph.Graph, error) {
+	g := depgraph.New()
+
+	names := maps.Keys(sweepers)
+	slices.Sort(names)
+
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	var errs []error
+	for _, name := range names {
+		for _, dependency := range sweepers[name].Dependencies {
+			if err := g.AddDependency(name, dependency); err != nil {
+				errs = append(errs, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency))
+			}
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	if _, err := g.OverallOrder(); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// filterSweepers returns the names of the sweepers matching the filter, and their dependencies, in dependency order.
+// Filtering matches that of the Terraform Plugin Testing framework.
+func filterSweepers(g *depgraph.Graph, filter string) ([]string, error) {
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	filters := strings.Split(strings.ToLower(filter), ",")
+	if len(filters) == 1 && filters[0] == "" {
+		return order, nil
+	}
+
+	selected := make(map[string]struct{})
+	for _, name := range order {
+		if !slices.ContainsFunc(filters, func(f string) bool {
+			return strings.Contains(strings.ToLower(name), f)
+		}) {
+			continue
+		}
+
+		selected[name] = struct{}{}
+
+		dependencies, err := g.DependenciesOf(name)
+		if err != nil {
+			return nil, err
+		}
+		for _, dependency := range dependencies {
+			selected[dependency] = struct{}{}
+		}
+	}
+
+	return slices.DeleteFunc(order, func(name string) bool {
+		_, ok := selected[name]
+		return !ok
+	}), nil
+}
+
+type sweeperResult struct {
+	name string
+	err  error
+}
+
+// runSweepers runs the named sweepers in the specified Region.
+// A sweeper is started once all of its dependencies have completed, with at most parallelism sweepers running at once.
+// The returned map contains the result of every sweeper that was run.
+func runSweepers(g *depgraph.Graph, sweepers map[string]*resource.Sweeper, names []string, region string, parallelism int, allowFailures bool) map[string]error {
+	results := make(map[string]error, len(names))
+
+	// Count each sweeper's outstanding dependencies.
+	waiting := make(map[string]int, len(names))
+	var ready []string
+	for _, name := range names {
+		dependencies, _ := g.DirectDependenciesOf(name)
+		waiting[name] = len(dependencies)
+		if waiting[name] == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	done := make(chan sweeperResult)
+	var running int
+	var failed bool
+
+	for {
+		for running < parallelism && len(ready) > 0 && !failed {
+			name := ready[0]
+			ready = ready[1:]
+			running++
+
+			if dryRun != nil {
+				dryRun.start(region, name)
+			}
+
+			go func(s *resource.Sweeper) {
+				log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", s.Name, region)
+				start := time.Now()
+				err := s.F(region)
+				log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", s.Name, region, time.Since(start))
+
+				done <- sweeperResult{name: name, err: err}
+			}(sweepers[name])
+		}
+
+		if running == 0 {
+			break
+		}
+
+		result := <-done
+		running--
+		results[result.name] = result.err
+
+		if result.err != nil {
+			log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", result.name, region, result.err)
+			if !allowFailures {
+				// Let running sweepers complete but don't start any more.
+				failed = true
+			}
+		}
+
+		dependents, _ := g.DirectDependentsOf(result.name)
+		for _, dependent := range dependents {
+			if _, ok := waiting[dependent]; !ok {
+				continue
+			}
+
+			waiting[dependent]--
+			if waiting[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	return results
+}
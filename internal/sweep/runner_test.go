@@ -0,0 +1,314 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestSweeperGraph(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		sweepers    map[string]*resource.Sweeper
+		expectError bool
+	}{
+		"no sweepers": {
+			sweepers: map[string]*resource.Sweeper{},
+		},
+		"valid": {
+			sweepers: testSweepers(map[string][]string{
+				"aws_a": {"aws_b", "aws_c"},
+				"aws_b": {"aws_c"},
+				"aws_c": nil,
+			}, nil),
+		},
+		"missing dependency": {
+			sweepers: testSweepers(map[string][]string{
+				"aws_a": {"aws_b"},
+			}, nil),
+			expectError: true,
+		},
+		"cycle": {
+			sweepers: testSweepers(map[string][]string{
+				"aws_a": {"aws_b"},
+				"aws_b": {"aws_c"},
+				"aws_c": {"aws_a"},
+			}, nil),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := sweeperGraph(testCase.sweepers)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("sweeperGraph err %t, want %t: %v", got, want, err)
+			}
+		})
+	}
+}
+
+func TestFilterSweepers(t *testing.T) {
+	t.Parallel()
+
+	sweepers := testSweepers(map[string][]string{
+		"aws_a": {"aws_b"},
+		"aws_b": {"aws_c"},
+		"aws_c": nil,
+		"aws_d": nil,
+	}, nil)
+
+	testCases := map[string]struct {
+		filter   string
+		expected []string
+	}{
+		"no filter": {
+			expected: []string{"aws_c", "aws_b", "aws_a", "aws_d"},
+		},
+		"with dependencies": {
+			filter:   "aws_b",
+			expected: []string{"aws_c", "aws_b"},
+		},
+		"multiple": {
+			filter:   "AWS_A,aws_d",
+			expected: []string{"aws_c", "aws_b", "aws_a", "aws_d"},
+		},
+		"no match": {
+			filter:   "aws_z",
+			expected: []string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			g, err := sweeperGraph(sweepers)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := filterSweepers(g, testCase.filter)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunSweepers(t *testing.T) {
+	t.Parallel()
+
+	dependencies := map[string][]string{
+		"aws_a": {"aws_b", "aws_c"},
+		"aws_b": {"aws_d"},
+		"aws_c": {"aws_d"},
+		"aws_d": nil,
+		"aws_e": nil,
+	}
+
+	testCases := map[string]struct {
+		parallelism   int
+		allowFailures bool
+		failing       string
+		expectedRun   []string
+		expectedError []string
+	}{
+		"serial": {
+			parallelism: 1,
+			expectedRun: []string{"aws_a", "aws_b", "aws_c", "aws_d", "aws_e"},
+		},
+		"parallel": {
+			parallelism: 4,
+			expectedRun: []string{"aws_a", "aws_b", "aws_c", "aws_d", "aws_e"},
+		},
+		"failure": {
+			parallelism:   1,
+			failing:       "aws_d",
+			expectedRun:   []string{"aws_d"},
+			expectedError: []string{"aws_d"},
+		},
+		"failure allowed": {
+			parallelism:   1,
+			allowFailures: true,
+			failing:       "aws_d",
+			expectedRun:   []string{"aws_a", "aws_b", "aws_c", "aws_d", "aws_e"},
+			expectedError: []string{"aws_d"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var mu sync.Mutex
+			var order []string
+			var active, maxActive int
+			sweepers := testSweepers(dependencies, func(name string) error {
+				mu.Lock()
+				order = append(order, name)
+				active++
+				maxActive = max(maxActive, active)
+				mu.Unlock()
+
+				time.Sleep(10 * time.Millisecond)
+
+				mu.Lock()
+				active--
+				mu.Unlock()
+
+				if name == testCase.failing {
+					return errors.New("failed")
+				}
+				return nil
+			})
+
+			g, err := sweeperGraph(sweepers)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			names, err := filterSweepers(g, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			results := runSweepers(g, sweepers, names, "us-west-2", testCase.parallelism, testCase.allowFailures) //lintignore:AWSAT003
+
+			var run, failed []string
+			for name, err := range results {
+				run = append(run, name)
+				if err != nil {
+					failed = append(failed, name)
+				}
+			}
+			slices.Sort(run)
+			slices.Sort(failed)
+
+			if diff := cmp.Diff(run, testCase.expectedRun); diff != "" {
+				t.Errorf("unexpected run diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(failed, testCase.expectedError); diff != "" {
+				t.Errorf("unexpected error diff (+wanted, -got): %s", diff)
+			}
+
+			if maxActive > testCase.parallelism {
+				t.Errorf("%d sweepers ran concurrently, want at most %d", maxActive, testCase.parallelism)
+			}
+
+			// Every sweeper must run after all its dependencies.
+			for i, name := range order {
+				for _, dependency := range dependencies[name] {
+					if !slices.Contains(order[:i], dependency) {
+						t.Errorf("sweeper %s ran before its dependency %s", name, dependency)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRunnerDryRun(t *testing.T) { //nolint:paralleltest // Dry-run mode is process-wide.
+	var report DryRunReport
+	sweepers := map[string]*resource.Sweeper{
+		"aws_a": {
+			Name: "aws_a",
+			F: func(region string) error {
+				return SweepOrchestrator(Context(region), []Sweepable{testSweepable("a-1"), testSweepable("a-2")})
+			},
+		},
+		"aws_b": {
+			Name: "aws_b",
+			F: func(region string) error {
+				err := SweepOrchestrator(Context(region), nil)
+				// Capture the report before it's discarded.
+				report = dryRun.report
+				return err
+			},
+			Dependencies: []string{"aws_a"},
+		},
+	}
+	runner := &Runner{DryRun: true, Parallelism: 4}
+
+	if err := runner.run([]string{"us-west-2"}, sweepers); err != nil { //lintignore:AWSAT003
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := DryRunReport{
+		"us-west-2": { //lintignore:AWSAT003
+			"aws_a": {"a-1", "a-2"},
+			"aws_b": {},
+		},
+	}
+	if diff := cmp.Diff(report, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"DescribeInstances":   true,
+		"GetBucketPolicy":     true,
+		"ListQueues":          true,
+		"BatchGetItem":        true,
+		"DeleteQueue":         false,
+		"TerminateInstances":  false,
+		"PutBucketPolicy":     false,
+		"BatchDeleteBuilds":   false,
+		"UpdateTableSettings": false,
+	}
+
+	for operation, expected := range testCases {
+		if got := isReadOnlyOperation(operation); got != expected {
+			t.Errorf("isReadOnlyOperation(%q) = %t, want %t", operation, got, expected)
+		}
+	}
+}
+
+func testSweepers(dependencies map[string][]string, f func(string) error) map[string]*resource.Sweeper {
+	sweepers := make(map[string]*resource.Sweeper, len(dependencies))
+
+	for name, dependencies := range dependencies {
+		sweepers[name] = &resource.Sweeper{
+			Name: name,
+			F: func(string) error {
+				if f == nil {
+					return nil
+				}
+				return f(name)
+			},
+			Dependencies: dependencies,
+		}
+	}
+
+	return sweepers
+}
+
+type testSweepable string
+
+func (s testSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	return errors.New("deleted in dry-run mode")
+}
+
+func (s testSweepable) String() string {
+	return string(s)
+}
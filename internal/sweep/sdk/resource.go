@@ -6,6 +6,7 @@ package sdk
 import (
 	"context"
 	"math/rand"
+	"reflect"
 	"strings"
 	"time"
 
@@ -22,12 +23,27 @@ import (
 
 type sweepResource struct {
 	d        *schema.ResourceData
+	importID string
 	meta     *conns.AWSClient
 	resource *schema.Resource
 }
 
-func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) *sweepResource {
+// SweepResourceOption configures a sweepable Plugin SDK resource.
+type SweepResourceOption func(*sweepResource)
+
+// WithImportID sets the ID with which the resource is imported.
+// It must be used for resources whose importer doesn't accept the resource's ID.
+func WithImportID(id string) SweepResourceOption {
+	return func(sr *sweepResource) {
+		sr.importID = id
+	}
+}
+
+func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient, optFns ...SweepResourceOption) *sweepResource {
 	s := newSweepResource(resource, d, meta)
+	for _, optFn := range optFns {
+		optFn(&s)
+	}
 	return &s
 }
 
@@ -39,6 +55,39 @@ func newSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *c
 	}
 }
 
+func (sr *sweepResource) String() string {
+	return sr.d.Id()
+}
+
+// ImportID returns the ID with which the resource is imported.
+// The resource's type name isn't known.
+// The resource's ID is only known to be its import ID if the resource's importer is a passthrough;
+// other resources are skipped unless their import ID is set with WithImportID.
+func (sr *sweepResource) ImportID(ctx context.Context) (string, string, error) {
+	if sr.importID != "" {
+		return "", sr.importID, nil
+	}
+
+	v := sr.resource.Importer
+	if v == nil {
+		return "", "", nil
+	}
+
+	if !isPassthroughImporter(v) {
+		tflog.Warn(ctx, "Import ID unknown: resource has a custom importer", map[string]any{
+			"id": sr.d.Id(),
+		})
+		return "", "", nil
+	}
+
+	return "", sr.d.Id(), nil
+}
+
+// isPassthroughImporter returns whether the importer imports a resource using its ID unchanged.
+func isPassthroughImporter(v *schema.ResourceImporter) bool {
+	return v.StateContext != nil && reflect.ValueOf(v.StateContext).Pointer() == reflect.ValueOf(schema.ImportStatePassthroughContext).Pointer()
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	ctx = tflog.SetField(ctx, "id", sr.d.Id())
 
@@ -136,3 +185,46 @@ func ReadResource(ctx context.Context, resource *schema.Resource, d *schema.Reso
 
 	return resource.Read(d, meta)
 }
+
+// NewImportedSweepResource returns a sweepable for the resource with the specified import ID, as read by the resource's Read function.
+// nil is returned if no such resource exists or if arn is not empty and the resource's `arn` attribute doesn't match it.
+func NewImportedSweepResource(ctx context.Context, resource *schema.Resource, id, arn string, meta *conns.AWSClient) (*sweepResource, error) {
+	d := resource.Data(nil)
+	d.SetId(id)
+
+	if v := resource.Importer; v != nil && (v.StateContext != nil || v.State != nil) {
+		var ds []*schema.ResourceData
+		var err error
+
+		if v.StateContext != nil {
+			ds, err = v.StateContext(ctx, d, meta)
+		} else {
+			ds, err = v.State(d, meta)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(ds) == 0 {
+			return nil, nil
+		}
+		d = ds[0]
+	}
+
+	if err := ReadResource(ctx, resource, d, meta); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, nil
+	}
+
+	if _, ok := resource.SchemaMap()["arn"]; ok && arn != "" {
+		if v, ok := d.Get("arn").(string); ok && v != "" && v != arn {
+			return nil, nil
+		}
+	}
+
+	return NewSweepResource(resource, d, meta), nil
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSweepResourceImportID(t *testing.T) {
+	t.Parallel()
+
+	customImporter := func(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+		d.SetId("custom")
+		return []*schema.ResourceData{d}, nil
+	}
+
+	testCases := map[string]struct {
+		importer *schema.ResourceImporter
+		optFns   []sdk.SweepResourceOption
+		expected string
+	}{
+		"no importer": {},
+		"passthrough importer": {
+			importer: &schema.ResourceImporter{
+				StateContext: schema.ImportStatePassthroughContext,
+			},
+			expected: "id-1",
+		},
+		"custom importer": {
+			importer: &schema.ResourceImporter{
+				StateContext: customImporter,
+			},
+		},
+		"custom importer with import ID": {
+			importer: &schema.ResourceImporter{
+				StateContext: customImporter,
+			},
+			optFns:   []sdk.SweepResourceOption{sdk.WithImportID("id-1,name")},
+			expected: "id-1,name",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &schema.Resource{
+				Importer: testCase.importer,
+				Schema: map[string]*schema.Schema{
+					names.AttrName: {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			}
+			d := r.Data(nil)
+			d.SetId("id-1")
+
+			typeName, id, err := sdk.NewSweepResource(r, d, &conns.AWSClient{}, testCase.optFns...).ImportID(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if typeName != "" {
+				t.Errorf("type name = %q, want empty", typeName)
+			}
+			if got, want := id, testCase.expected; got != want {
+				t.Errorf("import ID = %q, want %q", got, want)
+			}
+		})
+	}
+}
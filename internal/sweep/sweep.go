@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
+	"github.com/aws/smithy-go/middleware"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -34,22 +36,33 @@ var ServicePackages []conns.ServicePackage
 // This prevents client re-initialization for every resource with no benefit.
 var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
 
+// sweeperClientsLock serializes client creation as sweepers may run concurrently.
+var sweeperClientsLock sync.Mutex
+
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	sweeperClientsLock.Lock()
+	defer sweeperClientsLock.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
 
-	_, _, err := envvar.RequireOneOf([]string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running sweepers")
-	if err != nil {
-		return nil, err
-	}
+	// Sweepers can be run against recorded AWS API responses, without credentials.
+	offlineCassetteDir := os.Getenv(conns.OfflineCassetteDirEnvVar)
 
-	if os.Getenv(envvar.AccessKeyId) != "" {
-		_, err := envvar.Require(envvar.SecretAccessKey, "static credentials value when using "+envvar.AccessKeyId)
+	if offlineCassetteDir == "" {
+		_, _, err := envvar.RequireOneOf([]string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running sweepers")
 		if err != nil {
 			return nil, err
 		}
+
+		if os.Getenv(envvar.AccessKeyId) != "" {
+			_, err := envvar.Require(envvar.SecretAccessKey, "static credentials value when using "+envvar.AccessKeyId)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	meta := new(conns.AWSClient)
@@ -61,9 +74,14 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	meta.ServicePackages = servicePackageMap
 
 	conf := &conns.Config{
-		MaxRetries:       5,
-		Region:           region,
-		SuppressDebugLog: true,
+		MaxRetries:         5,
+		OfflineCassetteDir: offlineCassetteDir,
+		Region:             region,
+		SuppressDebugLog:   true,
+	}
+
+	if dryRun != nil {
+		conf.APIOptions = []func(*middleware.Stack) error{dryRunAPIOption}
 	}
 
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
@@ -112,6 +130,15 @@ func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tf
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
+	if dryRun != nil {
+		for _, sweepable := range sweepables {
+			dryRun.add(ctx, describeSweepable(sweepable))
+		}
+		dryRun.addSweepables(ctx, sweepables...)
+
+		return nil
+	}
+
 	var g multierror.Group
 
 	for _, sweepable := range sweepables {
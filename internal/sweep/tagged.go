@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
+)
+
+// TaggedSweeperName is the name of the sweeper that is run, in place of the registered sweepers, when sweeping tagged resources.
+const TaggedSweeperName = "aws_tagged_resources"
+
+// servicePackageARNNamespaces maps the names of service packages whose ARNs don't use the service package name as the service namespace.
+var servicePackageARNNamespaces = map[string]string{
+	"accessanalyzer":               "access-analyzer",
+	"acmpca":                       "acm-pca",
+	"amp":                          "aps",
+	"apigatewayv2":                 "apigateway",
+	"appautoscaling":               "application-autoscaling",
+	"appintegrations":              "app-integrations",
+	"bedrockagent":                 "bedrock",
+	"chimesdkmediapipelines":       "chime",
+	"chimesdkvoice":                "chime",
+	"cloudhsmv2":                   "cloudhsm",
+	"codestarconnections":          "codestar-connections",
+	"codestarnotifications":        "codestar-notifications",
+	"cognitoidentity":              "cognito-identity",
+	"cognitoidp":                   "cognito-idp",
+	"customerprofiles":             "profile",
+	"devopsguru":                   "devops-guru",
+	"docdb":                        "rds",
+	"ecrpublic":                    "ecr-public",
+	"efs":                          "elasticfilesystem",
+	"elasticsearch":                "es",
+	"elb":                          "elasticloadbalancing",
+	"elbv2":                        "elasticloadbalancing",
+	"emr":                          "elasticmapreduce",
+	"emrcontainers":                "emr-containers",
+	"emrserverless":                "emr-serverless",
+	"keyspaces":                    "cassandra",
+	"kinesisanalyticsv2":           "kinesisanalytics",
+	"lexv2models":                  "lex",
+	"licensemanager":               "license-manager",
+	"location":                     "geo",
+	"mwaa":                         "airflow",
+	"neptune":                      "rds",
+	"neptunegraph":                 "neptune-graph",
+	"networkfirewall":              "network-firewall",
+	"opensearch":                   "es",
+	"opensearchserverless":         "aoss",
+	"paymentcryptography":          "payment-cryptography",
+	"pinpoint":                     "mobiletargeting",
+	"redshiftserverless":           "redshift-serverless",
+	"resourceexplorer2":            "resource-explorer-2",
+	"resourcegroups":               "resource-groups",
+	"route53recoverycontrolconfig": "route53-recovery-control-config",
+	"route53recoveryreadiness":     "route53-recovery-readiness",
+	"s3control":                    "s3",
+	"s3outposts":                   "s3-outposts",
+	"servicecatalog":               "catalog",
+	"servicecatalogappregistry":    "servicecatalog",
+	"sesv2":                        "ses",
+	"sfn":                          "states",
+	"simpledb":                     "sdb",
+	"ssmcontacts":                  "ssm-contacts",
+	"ssmincidents":                 "ssm-incidents",
+	"ssoadmin":                     "sso",
+	"timestreamwrite":              "timestream",
+	"vpclattice":                   "vpc-lattice",
+	"wafregional":                  "waf-regional",
+	"workspacesweb":                "workspaces-web",
+}
+
+// runTagged sweeps the resources bearing the Runner's tag in each of the specified Regions.
+func (r *Runner) runTagged(regions []string) error {
+	key, value, _ := strings.Cut(r.Tag, "=")
+	if key == "" {
+		return fmt.Errorf("invalid sweeper tag (%s): expected key=value", r.Tag)
+	}
+
+	// The filter selects resource types rather than sweepers.
+	runner := *r
+	runner.Filter = ""
+
+	return runner.run(regions, map[string]*resource.Sweeper{
+		TaggedSweeperName: {
+			Name: TaggedSweeperName,
+			F: func(region string) error {
+				return sweepTaggedResources(region, key, value, r.Filter)
+			},
+		},
+	})
+}
+
+// sweepTaggedResources deletes the resources in the specified Region bearing the tag, using their resource types' Delete functions.
+// If value is empty, resources bearing the tag key with any value are deleted.
+// Resources whose types aren't selected by the filter, or can't be determined, are skipped.
+func sweepTaggedResources(region, key, value, filter string) error {
+	ctx := Context(region)
+	client, err := SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	conn := client.ResourceGroupsTaggingAPIClient(ctx)
+	resourceTypes := newTaggedResourceTypes(ctx, ServicePackages)
+	filters := strings.Split(strings.ToLower(filter), ",")
+
+	tagFilter := awstypes.TagFilter{
+		Key: aws.String(key),
+	}
+	if value != "" {
+		tagFilter.Values = []string{value}
+	}
+	input := resourcegroupstaggingapi.GetResourcesInput{
+		TagFilters: []awstypes.TagFilter{tagFilter},
+	}
+
+	var errs []error
+	sweepResources := make([]Sweepable, 0)
+
+	pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return fmt.Errorf("listing resources tagged %s: %w", key, err)
+		}
+
+		for _, v := range page.ResourceTagMappingList {
+			arn := aws.ToString(v.ResourceARN)
+
+			resourceType, ok := resourceTypes.forARN(arn)
+			if !ok {
+				log.Printf("[WARN] Skipping tagged resource (%s): unknown resource type", arn)
+				continue
+			}
+
+			if !slices.ContainsFunc(filters, func(f string) bool {
+				return strings.Contains(resourceType.typeName, f)
+			}) {
+				continue
+			}
+
+			sweepable, err := resourceType.sweepable(ctx, arn, client)
+			if err != nil {
+				log.Printf("[WARN] Skipping tagged resource (%s): %s", arn, err)
+				continue
+			}
+			if sweepable == nil {
+				// Deleted since it was listed.
+				continue
+			}
+
+			sweepResources = append(sweepResources, &taggedResource{
+				Sweepable: sweepable,
+				arn:       arn,
+				typeName:  resourceType.typeName,
+			})
+		}
+	}
+
+	if err := SweepOrchestrator(ctx, sweepResources); err != nil {
+		errs = append(errs, fmt.Errorf("sweeping resources tagged %s: %w", key, err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// taggedResource is a Sweepable found by its tags.
+type taggedResource struct {
+	Sweepable
+	arn      string
+	typeName string
+}
+
+func (r *taggedResource) String() string {
+	return fmt.Sprintf("%s (%s)", r.typeName, r.arn)
+}
+
+// ImportID returns the resource's type name and the ID with which it's imported.
+func (r *taggedResource) ImportID(ctx context.Context) (string, string, error) {
+	v, ok := r.Sweepable.(Importable)
+	if !ok {
+		return r.typeName, "", nil
+	}
+
+	_, id, err := v.ImportID(ctx)
+
+	return r.typeName, id, err
+}
+
+// taggedResourceType is a registered taggable resource type.
+type taggedResourceType struct {
+	typeName  string
+	sdk       func() *schema.Resource
+	framework func(context.Context) (fwresource.ResourceWithConfigure, error)
+}
+
+// sweepable returns the Sweepable for the resource with the specified ARN.
+// The import IDs derived from the ARN are tried in turn.
+// nil is returned if the resource no longer exists.
+func (t taggedResourceType) sweepable(ctx context.Context, arn string, client *conns.AWSClient) (Sweepable, error) {
+	ids, err := taggedResourceIDs(arn)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	for _, id := range ids {
+		if t.framework != nil {
+			v, err := framework.NewImportedSweepResource(ctx, t.framework, id, arn, client)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if v != nil {
+				return v, nil
+			}
+		} else {
+			v, err := sdk.NewImportedSweepResource(ctx, t.sdk(), id, arn, client)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if v != nil {
+				return v, nil
+			}
+		}
+	}
+
+	if len(errs) == len(ids) {
+		return nil, fmt.Errorf("reading %s: %w", t.typeName, errors.Join(errs...))
+	}
+
+	return nil, nil
+}
+
+// taggedResourceTypes indexes the registered taggable resource types by ARN service namespace.
+type taggedResourceTypes map[string][]taggedResourceType
+
+func newTaggedResourceTypes(ctx context.Context, servicePackages []conns.ServicePackage) taggedResourceTypes {
+	resourceTypes := make(taggedResourceTypes)
+
+	for _, sp := range servicePackages {
+		namespace := sp.ServicePackageName()
+		if v, ok := servicePackageARNNamespaces[namespace]; ok {
+			namespace = v
+		}
+
+		for _, v := range sp.SDKResources(ctx) {
+			if v.Tags == nil {
+				continue
+			}
+			resourceTypes[namespace] = append(resourceTypes[namespace], taggedResourceType{
+				typeName: v.TypeName,
+				sdk:      v.Factory,
+			})
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			if v.Tags == nil {
+				continue
+			}
+			resourceType := taggedResourceType{
+				framework: v.Factory,
+			}
+			if r, err := v.Factory(ctx); err == nil {
+				var response fwresource.MetadataResponse
+				r.Metadata(ctx, fwresource.MetadataRequest{}, &response)
+				resourceType.typeName = response.TypeName
+			}
+			if resourceType.typeName == "" {
+				continue
+			}
+			resourceTypes[namespace] = append(resourceTypes[namespace], resourceType)
+		}
+	}
+
+	return resourceTypes
+}
+
+// forARN returns the resource type of the resource with the specified ARN.
+// The resource type is the service's taggable resource type whose name ends with the ARN's resource type, for example
+// `aws_iam_role` for `arn:aws:iam::123456789012:role/example`, preferring the shortest such name.
+// ARNs without a resource type match the service's only taggable resource type.
+func (t taggedResourceTypes) forARN(s string) (taggedResourceType, bool) {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return taggedResourceType{}, false
+	}
+
+	candidates := t[v.Service]
+	token, _ := arnResourceType(v.Resource)
+
+	if token == "" {
+		if len(candidates) == 1 {
+			return candidates[0], true
+		}
+		return taggedResourceType{}, false
+	}
+
+	token = normalizeResourceTypeName(token)
+	var match *taggedResourceType
+	for i, candidate := range candidates {
+		if !strings.HasSuffix(normalizeResourceTypeName(candidate.typeName), token) {
+			continue
+		}
+		if match == nil || len(candidate.typeName) < len(match.typeName) || (len(candidate.typeName) == len(match.typeName) && candidate.typeName < match.typeName) {
+			match = &candidates[i]
+		}
+	}
+
+	if match == nil {
+		return taggedResourceType{}, false
+	}
+
+	return *match, true
+}
+
+// taggedResourceIDs returns the import IDs to try for the resource with the specified ARN:
+// the ARN, the ARN's resource part without its resource type, and the final segment of the resource part.
+func taggedResourceIDs(s string) ([]string, error) {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{s}
+
+	resource := v.Resource
+	if _, rest := arnResourceType(resource); rest != "" {
+		resource = rest
+	}
+	ids = append(ids, resource)
+
+	if i := strings.LastIndexAny(resource, "/:"); i >= 0 {
+		ids = append(ids, resource[i+1:])
+	}
+
+	return slices.Compact(slices.DeleteFunc(ids, func(id string) bool {
+		return id == ""
+	})), nil
+}
+
+// arnResourceType splits an ARN's resource part into the resource type and the rest.
+// The resource type is empty if the resource part has no `/` or `:` separator.
+func arnResourceType(resource string) (string, string) {
+	i := strings.IndexAny(resource, "/:")
+	if i < 0 {
+		return "", resource
+	}
+
+	return resource[:i], resource[i+1:]
+}
+
+func normalizeResourceTypeName(s string) string {
+	return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(s))
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+type testServicePackage struct {
+	name       string
+	taggable   []string
+	untaggable []string
+}
+
+func (sp testServicePackage) FrameworkDataSources(context.Context) []*types.ServicePackageFrameworkDataSource {
+	return nil
+}
+
+func (sp testServicePackage) FrameworkResources(context.Context) []*types.ServicePackageFrameworkResource {
+	return nil
+}
+
+func (sp testServicePackage) SDKDataSources(context.Context) []*types.ServicePackageSDKDataSource {
+	return nil
+}
+
+func (sp testServicePackage) SDKResources(context.Context) []*types.ServicePackageSDKResource {
+	var resources []*types.ServicePackageSDKResource
+	for _, typeName := range sp.taggable {
+		resources = append(resources, &types.ServicePackageSDKResource{
+			Factory:  func() *schema.Resource { return &schema.Resource{} },
+			TypeName: typeName,
+			Tags:     &types.ServicePackageResourceTags{},
+		})
+	}
+	for _, typeName := range sp.untaggable {
+		resources = append(resources, &types.ServicePackageSDKResource{
+			Factory:  func() *schema.Resource { return &schema.Resource{} },
+			TypeName: typeName,
+		})
+	}
+
+	return resources
+}
+
+func (sp testServicePackage) ServicePackageName() string {
+	return sp.name
+}
+
+func TestTaggedResourceTypesForARN(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	resourceTypes := newTaggedResourceTypes(ctx, []conns.ServicePackage{
+		testServicePackage{
+			name:       "dynamodb",
+			taggable:   []string{"aws_dynamodb_table", "aws_dynamodb_global_table"},
+			untaggable: []string{"aws_dynamodb_table_item"},
+		},
+		testServicePackage{
+			name:     "ec2",
+			taggable: []string{"aws_vpc", "aws_default_vpc", "aws_security_group", "aws_subnet"},
+		},
+		testServicePackage{
+			name:     "iam",
+			taggable: []string{"aws_iam_role", "aws_iam_user"},
+		},
+		testServicePackage{
+			name:     "sfn",
+			taggable: []string{"aws_sfn_activity", "aws_sfn_state_machine"},
+		},
+		testServicePackage{
+			name:     "sqs",
+			taggable: []string{"aws_sqs_queue"},
+		},
+	})
+
+	testCases := map[string]struct {
+		arn      string
+		expected string
+	}{
+		"invalid ARN": {
+			arn: "example",
+		},
+		"unknown service": {
+			arn: "arn:aws:s3:::example", //lintignore:AWSAT005
+		},
+		"shortest match": {
+			arn:      "arn:aws:dynamodb:us-west-2:123456789012:table/example", //lintignore:AWSAT003,AWSAT005
+			expected: "aws_dynamodb_table",
+		},
+		"shortest match over default": {
+			arn:      "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678", //lintignore:AWSAT003,AWSAT005
+			expected: "aws_vpc",
+		},
+		"hyphenated resource type": {
+			arn:      "arn:aws:ec2:us-west-2:123456789012:security-group/sg-12345678", //lintignore:AWSAT003,AWSAT005
+			expected: "aws_security_group",
+		},
+		"unknown resource type": {
+			arn: "arn:aws:ec2:us-west-2:123456789012:instance/i-12345678", //lintignore:AWSAT003,AWSAT005
+		},
+		"global service": {
+			arn:      "arn:aws:iam::123456789012:role/path/example", //lintignore:AWSAT005
+			expected: "aws_iam_role",
+		},
+		"ARN namespace": {
+			arn:      "arn:aws:states:us-west-2:123456789012:stateMachine:example", //lintignore:AWSAT003,AWSAT005
+			expected: "aws_sfn_state_machine",
+		},
+		"no resource type": {
+			arn:      "arn:aws:sqs:us-west-2:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			expected: "aws_sqs_queue",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resourceType, ok := resourceTypes.forARN(testCase.arn)
+
+			if got, want := ok, testCase.expected != ""; got != want {
+				t.Fatalf("forARN(%q) ok %t, want %t", testCase.arn, got, want)
+			}
+
+			if got, want := resourceType.typeName, testCase.expected; got != want {
+				t.Errorf("forARN(%q) = %q, want %q", testCase.arn, got, want)
+			}
+		})
+	}
+}
+
+func TestTaggedResourceIDs(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn         string
+		expected    []string
+		expectError bool
+	}{
+		"invalid ARN": {
+			arn:         "example",
+			expectError: true,
+		},
+		"no resource type": {
+			arn:      "arn:aws:sqs:us-west-2:123456789012:example",                      //lintignore:AWSAT003,AWSAT005
+			expected: []string{"arn:aws:sqs:us-west-2:123456789012:example", "example"}, //lintignore:AWSAT003,AWSAT005
+		},
+		"resource type": {
+			arn:      "arn:aws:dynamodb:us-west-2:123456789012:table/example",                      //lintignore:AWSAT003,AWSAT005
+			expected: []string{"arn:aws:dynamodb:us-west-2:123456789012:table/example", "example"}, //lintignore:AWSAT003,AWSAT005
+		},
+		"path": {
+			arn:      "arn:aws:iam::123456789012:role/path/example",                                      //lintignore:AWSAT005
+			expected: []string{"arn:aws:iam::123456789012:role/path/example", "path/example", "example"}, //lintignore:AWSAT005
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := taggedResourceIDs(testCase.arn)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("taggedResourceIDs err %t, want %t: %v", got, want, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunnerTaggedInvalidTag(t *testing.T) {
+	t.Parallel()
+
+	runner := &Runner{Tag: "=value"}
+
+	if err := runner.Run([]string{"us-west-2"}); err == nil { //lintignore:AWSAT003
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// ARNPattern matches the ARNs of an AWS service's resources.
+type ARNPattern struct {
+	partitions []string
+	service    string
+	resources  []string
+	regexps    []*regexp.Regexp
+}
+
+// ARNOf returns an ARNPattern that matches ARNs in any partition with the specified service namespace, e.g. `sqs`.
+// If resource patterns are specified, e.g. `role/*`, the ARN's resource must also match one of them.
+// Use InPartitions to also constrain the ARN's partition.
+// `*` in a resource pattern matches any sequence of characters, including `/` and `:`.
+func ARNOf(service string, resources ...string) ARNPattern {
+	regexps := make([]*regexp.Regexp, len(resources))
+	for i, resource := range resources {
+		regexps[i] = regexache.MustCompile(`^` + strings.ReplaceAll(regexp.QuoteMeta(resource), `\*`, `.*`) + `$`)
+	}
+
+	return ARNPattern{
+		service:   service,
+		resources: resources,
+		regexps:   regexps,
+	}
+}
+
+// InPartitions returns a copy of the pattern that only matches ARNs in one of the specified partitions, e.g. `aws-us-gov`.
+func (p ARNPattern) InPartitions(partitions ...string) ARNPattern {
+	p.partitions = slices.Clone(partitions)
+
+	return p
+}
+
+// Match returns whether the ARN matches the pattern.
+func (p ARNPattern) Match(v arn.ARN) bool {
+	if len(p.partitions) > 0 && !slices.Contains(p.partitions, v.Partition) {
+		return false
+	}
+
+	if v.Service != p.service {
+		return false
+	}
+
+	if len(p.regexps) == 0 {
+		return true
+	}
+
+	for _, re := range p.regexps {
+		if re.MatchString(v.Resource) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String returns a human readable description of the pattern, e.g. `iam (role/*)` or `sqs in aws-cn`.
+func (p ARNPattern) String() string {
+	s := p.service
+	if len(p.resources) > 0 {
+		s += " (" + strings.Join(p.resources, " or ") + ")"
+	}
+	if len(p.partitions) > 0 {
+		s += " in " + strings.Join(p.partitions, " or ")
+	}
+
+	return s
+}
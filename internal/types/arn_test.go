@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+func TestARNPatternMatch(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		pattern ARNPattern
+		arn     string
+		want    bool
+	}{
+		{ARNOf("sqs"), "arn:aws:sqs:us-west-2:123456789012:queue", true},                                           //lintignore:AWSAT003,AWSAT005
+		{ARNOf("sqs"), "arn:aws:lambda:us-west-2:123456789012:function:queue", false},                              //lintignore:AWSAT003,AWSAT005
+		{ARNOf("iam", "role/*"), "arn:aws:iam::123456789012:role/service-role/example", true},                      //lintignore:AWSAT005
+		{ARNOf("iam", "role/*"), "arn:aws-us-gov:iam::123456789012:role/example", true},                            //lintignore:AWSAT005
+		{ARNOf("iam", "role/*"), "arn:aws:iam::123456789012:user/example", false},                                  //lintignore:AWSAT005
+		{ARNOf("iam", "role/*"), "arn:aws:iam::123456789012:role", false},                                          //lintignore:AWSAT005
+		{ARNOf("kms", "key/*", "alias/*"), "arn:aws:kms:us-west-2:123456789012:alias/example", true},               //lintignore:AWSAT003,AWSAT005
+		{ARNOf("dynamodb", "table/*/stream/*"), "arn:aws:dynamodb:us-west-2:123456789012:table/t", false},          //lintignore:AWSAT003,AWSAT005
+		{ARNOf("lambda", "function:*"), "arn:aws:lambda:us-west-2:123456789012:function:f:1", true},                //lintignore:AWSAT003,AWSAT005
+		{ARNOf("s3", "example.bucket"), "arn:aws:s3:::exampleXbucket", false},                                      //lintignore:AWSAT005
+		{ARNOf("iam", "role/*").InPartitions("aws-us-gov"), "arn:aws-us-gov:iam::123456789012:role/example", true}, //lintignore:AWSAT005
+		{ARNOf("iam", "role/*").InPartitions("aws-us-gov"), "arn:aws:iam::123456789012:role/example", false},       //lintignore:AWSAT005
+		{ARNOf("sqs").InPartitions("aws", "aws-cn"), "arn:aws-cn:sqs:cn-north-1:123456789012:queue", true},         //lintignore:AWSAT003,AWSAT005
+	} {
+		v, err := arn.Parse(tc.arn)
+		if err != nil {
+			t.Fatalf("parsing %s: %s", tc.arn, err)
+		}
+
+		if got, want := tc.pattern.Match(v), tc.want; got != want {
+			t.Errorf("ARNOf(%s).Match(%q) = %v, want %v", tc.pattern, tc.arn, got, want)
+		}
+	}
+}
+
+func TestARNPatternString(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		pattern ARNPattern
+		want    string
+	}{
+		{ARNOf("sqs"), "sqs"},
+		{ARNOf("iam", "role/*"), "iam (role/*)"},
+		{ARNOf("kms", "key/*", "alias/*"), "kms (key/* or alias/*)"},
+		{ARNOf("sqs").InPartitions("aws-cn"), "sqs in aws-cn"},
+		{ARNOf("iam", "role/*").InPartitions("aws", "aws-us-gov"), "iam (role/*) in aws or aws-us-gov"},
+	} {
+		if got, want := tc.pattern.String(), tc.want; got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestARNPatternInPartitionsCopies(t *testing.T) {
+	t.Parallel()
+
+	pattern := ARNOf("sqs")
+	_ = pattern.InPartitions("aws-cn")
+
+	if got, want := pattern.String(), "sqs"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"github.com/YakDriver/regexache"
+)
+
+// AWSPartitionPattern is the regular expression matched by valid AWS partition IDs.
+const AWSPartitionPattern = `^aws(-[a-z]+)*$`
+
+// IsAWSPartition returns whether or not the specified string is a valid AWS partition ID.
+func IsAWSPartition(s string) bool { // nosemgrep:ci.aws-in-func-name
+	return regexache.MustCompile(AWSPartitionPattern).MatchString(s)
+}
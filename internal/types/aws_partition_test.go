@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import "testing"
+
+func TestIsAWSPartition(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	for _, tc := range []struct {
+		partition string
+		valid     bool
+	}{
+		{"aws", true},
+		{"aws-cn", true},
+		{"aws-us-gov", true},
+		{"aws-iso-b", true},
+		{"", false},
+		{"amazon", false},
+		{"AWS", false},
+		{"aws-", false},
+	} {
+		ok := IsAWSPartition(tc.partition)
+		if got, want := ok, tc.valid; got != want {
+			t.Errorf("IsAWSPartition(%q) = %v, want %v", tc.partition, got, want)
+		}
+	}
+}
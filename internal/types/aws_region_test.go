@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import "testing"
+
+func TestIsAWSRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	for _, tc := range []struct {
+		region string
+		valid  bool
+	}{
+		{"us-east-1", true},
+		{"us-gov-west-1", true},
+		{"cn-northwest-1", true},
+		{"us-isob-east-1", true},
+		{"", false},
+		{"aws-global", false},
+		{"us-east", false},
+		{"US-EAST-1", false},
+		{"us-east-1a", false},
+	} {
+		ok := IsAWSRegion(tc.region)
+		if got, want := ok, tc.valid; got != want {
+			t.Errorf("IsAWSRegion(%q) = %v, want %v", tc.region, got, want)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+var (
+	replayTransports     = make(map[string]*replayTransport)
+	replayTransportsLock sync.Mutex
+)
+
+// NewReplayTransport returns an http.RoundTripper that serves responses from all the cassettes in the specified directory.
+// The cassettes are loaded once per process and shared by all callers.
+// No request is sent to AWS. A request with no recorded response fails with an error wrapping cassette.ErrInteractionNotFound.
+func NewReplayTransport(dir string) (http.RoundTripper, error) {
+	replayTransportsLock.Lock()
+	defer replayTransportsLock.Unlock()
+
+	if t, ok := replayTransports[dir]; ok {
+		return t, nil
+	}
+
+	var cassettes []*cassette.Cassette
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || filepath.Ext(path) != ".yaml" {
+			return nil
+		}
+
+		c, err := cassette.Load(strings.TrimSuffix(path, ".yaml"))
+		if err != nil {
+			return fmt.Errorf("loading cassette (%s): %w", path, err)
+		}
+
+		cassettes = append(cassettes, c)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading cassettes from %s: %w", dir, err)
+	}
+
+	if len(cassettes) == 0 {
+		return nil, fmt.Errorf("no cassettes found in %s", dir)
+	}
+
+	t := &replayTransport{
+		cassettes: cassettes,
+		dir:       dir,
+		replayed:  make(map[*cassette.Interaction]bool),
+	}
+	replayTransports[dir] = t
+
+	return t, nil
+}
+
+type replayTransport struct {
+	cassettes []*cassette.Cassette
+	dir       string
+	lock      sync.Mutex
+	replayed  map[*cassette.Interaction]bool
+}
+
+func (t *replayTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	var body string
+	if r.Body != nil {
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			return nil, fmt.Errorf("reading request body: %w", err)
+		}
+		r.Body.Close()
+		r.Body = io.NopCloser(&b)
+		body = b.String()
+	}
+
+	i := t.interaction(r, body)
+	if i == nil {
+		return nil, fmt.Errorf("offline mode: %s %s: %w in %s", r.Method, r.URL, cassette.ErrInteractionNotFound, t.dir)
+	}
+
+	response, err := i.GetHTTPResponse()
+	if err != nil {
+		return nil, err
+	}
+	response.Request = r
+
+	return response, nil
+}
+
+// interaction returns the recorded interaction matching the request.
+// Interactions that have not yet been replayed are preferred so that sequences of identical requests,
+// e.g. while waiting for a resource to become available, replay in their recorded order.
+// Once all have been replayed the last, most recent, matching interaction is repeated.
+func (t *replayTransport) interaction(r *http.Request, body string) *cassette.Interaction {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	var match *cassette.Interaction
+	for _, c := range t.cassettes {
+		for _, i := range c.Interactions {
+			if !t.matches(r, body, i.Request) {
+				continue
+			}
+
+			if !t.replayed[i] {
+				t.replayed[i] = true
+				return i
+			}
+
+			match = i
+		}
+	}
+
+	return match
+}
+
+// matches returns whether the request matches the recorded request.
+// Headers are ignored as they contain request signatures and timestamps.
+func (t *replayTransport) matches(r *http.Request, body string, i cassette.Request) bool {
+	if r.Method != i.Method || r.URL.String() != i.URL {
+		return false
+	}
+
+	return BodyMatches(r.Context(), r.Header.Get("Content-Type"), body, i.Body)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+func TestBodyMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		contentType string
+		body        string
+		recorded    string
+		expected    bool
+	}{
+		"identical": {
+			body:     "abc",
+			recorded: "abc",
+			expected: true,
+		},
+		"different": {
+			contentType: "text/plain",
+			body:        "abc",
+			recorded:    "abd",
+		},
+		"JSON reordered": {
+			contentType: "application/x-amz-json-1.1",
+			body:        `{"a":1,"b":"x"}`,
+			recorded:    `{"b":"x","a":1}`,
+			expected:    true,
+		},
+		"JSON different": {
+			contentType: "application/x-amz-json-1.1",
+			body:        `{"a":1,"b":"x"}`,
+			recorded:    `{"a":2,"b":"x"}`,
+		},
+		"query reordered": {
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			body:        "Action=DescribeVpcs&Version=2016-11-15",
+			recorded:    "Version=2016-11-15&Action=DescribeVpcs",
+			expected:    true,
+		},
+		"query different": {
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			body:        "Action=DescribeVpcs&Version=2016-11-15",
+			recorded:    "Action=DescribeSubnets&Version=2016-11-15",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := vcr.BodyMatches(context.Background(), testCase.contentType, testCase.body, testCase.recorded), testCase.expected; got != want {
+				t.Errorf("BodyMatches = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestReplayTransport(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	c := cassette.New(filepath.Join(dir, "test"))
+	for _, body := range []string{"pending", "available"} {
+		c.AddInteraction(&cassette.Interaction{
+			Request: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://example.com/",
+				Body:   `{"Name":"test"}`,
+			},
+			Response: cassette.Response{
+				Code: http.StatusOK,
+				Body: body,
+			},
+		})
+	}
+	if err := c.Save(); err != nil {
+		t.Fatalf("saving cassette: %s", err)
+	}
+
+	transport, err := vcr.NewReplayTransport(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	client := &http.Client{Transport: transport}
+
+	// Recorded responses are replayed in order, with the last repeated.
+	for _, want := range []string{"pending", "available", "available"} {
+		if got := testReplay(t, client, `{"Name":"test"}`); got != want {
+			t.Errorf("response body = %q, want %q", got, want)
+		}
+	}
+
+	_, err = client.Post("https://example.com/", "application/json", strings.NewReader(`{"Name":"other"}`))
+	if !errors.Is(err, cassette.ErrInteractionNotFound) {
+		t.Errorf("expected cassette.ErrInteractionNotFound, got %v", err)
+	}
+}
+
+func TestNewReplayTransportNoCassettes(t *testing.T) {
+	t.Parallel()
+
+	if _, err := vcr.NewReplayTransport(t.TempDir()); err == nil {
+		t.Error("expected error, got none")
+	}
+}
+
+func testReplay(t *testing.T, client *http.Client, body string) string {
+	t.Helper()
+
+	response, err := client.Post("https://example.com/", "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer response.Body.Close()
+
+	b, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatalf("reading response body: %s", err)
+	}
+
+	return string(b)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"mime"
+	"net/url"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// BodyMatches returns whether an HTTP request body matches a recorded request body.
+// Bodies match if they are identical or, for the AWS protocols' structured content types, semantically equal.
+// See https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+func BodyMatches(ctx context.Context, contentType, body, recorded string) bool {
+	// If body matches identically, we are done.
+	if body == recorded {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		// JSON might be the same, but reordered. Try parsing and comparing.
+		var requestJson, cassetteJson any
+
+		if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		if err := json.Unmarshal([]byte(recorded), &cassetteJson); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		return reflect.DeepEqual(requestJson, cassetteJson)
+
+	case "application/xml":
+		// XML might be the same, but reordered. Try parsing and comparing.
+		var requestXml, cassetteXml any
+
+		if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		if err := xml.Unmarshal([]byte(recorded), &cassetteXml); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		return reflect.DeepEqual(requestXml, cassetteXml)
+
+	case "application/x-www-form-urlencoded":
+		// Query protocol parameters might be the same, but reordered. Try parsing and comparing.
+		requestValues, err := url.ParseQuery(body)
+		if err != nil {
+			return false
+		}
+
+		cassetteValues, err := url.ParseQuery(recorded)
+		if err != nil {
+			return false
+		}
+
+		return reflect.DeepEqual(requestValues, cassetteValues)
+	}
+
+	return false
+}
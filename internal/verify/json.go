@@ -15,6 +15,7 @@ import (
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 )
 
 // SuppressEquivalentPolicyDiffs returns a difference suppression function that compares
@@ -65,6 +66,15 @@ func SuppressEquivalentJSONWithEmptyDiffs(k, old, new string, d *schema.Resource
 	return JSONStringsEqual(old, new)
 }
 
+// SuppressEquivalentNormalizedJSONDiffs returns a difference suppression function that compares
+// two JSON strings and returns `true` if they are equal once normalized by the specified normalizers.
+// This is the Plugin SDK equivalent of the Plugin Framework fwtypes.JSONDocument custom type.
+func SuppressEquivalentNormalizedJSONDiffs(normalizers ...tfjson.Normalizer) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		return tfjson.EquivalentStrings(old, new, normalizers...)
+	}
+}
+
 func SuppressEquivalentJSONOrYAMLDiffs(k, old, new string, d *schema.ResourceData) bool {
 	normalizedOld, err := NormalizeJSONOrYAMLString(old)
 
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 )
 
 func TestLooksLikeJSONString(t *testing.T) {
@@ -432,6 +433,29 @@ func TestSuppressEquivalentJSONDiffsWhitespaceAndNoWhitespace(t *testing.T) {
 	}
 }
 
+func TestSuppressEquivalentNormalizedJSONDiffs(t *testing.T) {
+	t.Parallel()
+
+	d := new(schema.ResourceData)
+	f := SuppressEquivalentNormalizedJSONDiffs(tfjson.NormalizeNumbers, tfjson.StripEmpty)
+
+	v1 := `{"widgets":[{"type":"metric","x":0,"width":12.0}]}`
+	v2 := `
+{
+  "widgets": [{"type": "metric", "x": 0, "width": 12, "properties": {}}]
+}`
+
+	if !f("", v1, v2, d) {
+		t.Errorf("Expected SuppressEquivalentNormalizedJSONDiffs to return true for %s == %s", v1, v2)
+	}
+
+	v2Diff := `{"widgets":[{"type":"metric","x":0,"width":6}]}`
+
+	if f("", v1, v2Diff, d) {
+		t.Errorf("Expected SuppressEquivalentNormalizedJSONDiffs to return false for %s == %s", v1, v2Diff)
+	}
+}
+
 func TestSuppressEquivalentJSONOrYAMLDiffs(t *testing.T) {
 	t.Parallel()
 
@@ -28,7 +28,6 @@ import (
 )
 
 var accountIDRegexp = regexache.MustCompile(`^(aws|aws-managed|third-party|\d{12}|cw.{10})$`)
-var partitionRegexp = regexache.MustCompile(`^aws(-[a-z]+)*$`)
 var regionRegexp = regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
 
 // validates all listed in https://gist.github.com/shortjared/4c1e3fe52bdfa47522cfe5b41e5d6f22
@@ -116,8 +115,8 @@ func ValidARNCheck(f ...ARNCheckFunc) schema.SchemaValidateFunc {
 
 		if parsedARN.Partition == "" {
 			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: missing partition value", k, value))
-		} else if !partitionRegexp.MatchString(parsedARN.Partition) {
-			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid partition value (expecting to match regular expression: %s)", k, value, partitionRegexp))
+		} else if !itypes.IsAWSPartition(parsedARN.Partition) {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid partition value (expecting to match regular expression: %s)", k, value, itypes.AWSPartitionPattern))
 		}
 
 		if parsedARN.Region != "" && !regionRegexp.MatchString(parsedARN.Region) {
@@ -142,6 +141,25 @@ func ValidARNCheck(f ...ARNCheckFunc) schema.SchemaValidateFunc {
 	}
 }
 
+// ValidARNOf validates that a string value is the ARN of a resource of the specified service, e.g. `ValidARNOf("sqs")`,
+// whose resource part matches one of the specified patterns, e.g. `ValidARNOf("iam", "role/*")`.
+// See itypes.ARNOf.
+func ValidARNOf(service string, resources ...string) schema.SchemaValidateFunc {
+	return ValidARNOfPattern(itypes.ARNOf(service, resources...))
+}
+
+// ValidARNOfPattern validates that a string value is an ARN matching the specified pattern,
+// e.g. `ValidARNOfPattern(itypes.ARNOf("iam", "role/*").InPartitions("aws-us-gov"))`.
+func ValidARNOfPattern(pattern itypes.ARNPattern) schema.SchemaValidateFunc {
+	return ValidARNCheck(func(v any, k string, parsedARN arn.ARN) (ws []string, errors []error) {
+		if !pattern.Match(parsedARN) {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: expected the ARN of a %s resource", k, v, pattern))
+		}
+
+		return ws, errors
+	})
+}
+
 func ValidAccountID(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
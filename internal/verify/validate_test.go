@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
 func TestValidAmazonSideASN(t *testing.T) {
@@ -225,6 +226,68 @@ func TestValidARN(t *testing.T) {
 	}
 }
 
+func TestValidARNOf(t *testing.T) {
+	t.Parallel()
+
+	f := ValidARNOf("iam", "role/*")
+
+	validNames := []string{
+		"",
+		"arn:aws:iam::123456789012:role/example", // lintignore:AWSAT005
+		"arn:aws:iam::123456789012:role/service-role/example",      // lintignore:AWSAT005
+		"arn:aws-us-gov:iam::123456789012:role/aws-service-role/x", // lintignore:AWSAT005
+	}
+	for _, v := range validNames {
+		_, errors := f(v, "arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid IAM role ARN: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"arn",
+		"arn:aws:iam::123456789012:user/example", // lintignore:AWSAT005
+		"arn:aws:iam::123456789012:role",         // lintignore:AWSAT005
+		"arn:aws:sqs:us-west-2:123456789012:role/example",             // lintignore:AWSAT003,AWSAT005
+		"arn:aws:lambda:us-west-2:123456789012:function:role/example", // lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range invalidNames {
+		_, errors := f(v, "arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid IAM role ARN", v)
+		}
+	}
+}
+
+func TestValidARNOfPattern(t *testing.T) {
+	t.Parallel()
+
+	f := ValidARNOfPattern(itypes.ARNOf("iam", "role/*").InPartitions("aws-us-gov"))
+
+	validNames := []string{
+		"",
+		"arn:aws-us-gov:iam::123456789012:role/example", // lintignore:AWSAT005
+	}
+	for _, v := range validNames {
+		_, errors := f(v, "arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid AWS GovCloud (US) IAM role ARN: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"arn:aws:iam::123456789012:role/example",        // lintignore:AWSAT005
+		"arn:aws-us-gov:iam::123456789012:user/example", // lintignore:AWSAT005
+		"arn:aws-cn:iam::123456789012:role/example",     // lintignore:AWSAT005
+	}
+	for _, v := range invalidNames {
+		_, errors := f(v, "arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid AWS GovCloud (US) IAM role ARN", v)
+		}
+	}
+}
+
 func TestValidCIDRNetworkAddress(t *testing.T) {
 	t.Parallel()
 
@@ -16,13 +16,14 @@ var (
 	v1            bool
 	pluginSDKV2   bool
 	includeTags   bool
+	sdkOperation  string
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return resource.Create(name, snakeName, !clearComments, force, !v1, !pluginSDKV2, includeTags)
+		return resource.Create(name, snakeName, !clearComments, force, !v1, !pluginSDKV2, includeTags, sdkOperation)
 	},
 }
 
@@ -35,4 +36,5 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&v1, "v1", "o", false, "generate for AWS Go SDK v1 (some existing services)")
 	resourceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for Terraform Plugin SDK V2")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().StringVarP(&sdkOperation, "sdk-operation", "k", "", "generate from the AWS Go SDK v2 types of a Create operation (e.g., bedrockagent.CreateAgent)")
 }
@@ -20,7 +20,7 @@ func ToSnakeCase(upper string, override string) string {
 		return override
 	}
 
-	re := regexache.MustCompile(`([a-z])([A-Z]{2,})`)
+	re := regexache.MustCompile(`([a-z])([A-Z]{2,}|[A-Z]$)`)
 	upper = re.ReplaceAllString(upper, `${1}_${2}`)
 
 	re2 := regexache.MustCompile(`([A-Z][a-z])`)
@@ -43,6 +43,11 @@ func TestToSnakeName(t *testing.T) {
 			Input:    "DBInstanceVPCEndpoint",
 			Expected: "db_instance_vpc_endpoint",
 		},
+		{
+			TestName: "trailing single upper",
+			Input:    "TopK",
+			Expected: "top_k",
+		},
 	}
 
 	for _, testCase := range testCases {
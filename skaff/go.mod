@@ -6,6 +6,7 @@ require (
 	github.com/YakDriver/regexache v0.24.0
 	github.com/hashicorp/terraform-provider-aws v1.60.1-0.20220322001452-8f7a597d0c24
 	github.com/spf13/cobra v1.8.1
+	golang.org/x/tools v0.26.0
 )
 
 require (
@@ -21,7 +22,6 @@ require (
 	golang.org/x/mod v0.21.0 // indirect
 	golang.org/x/sync v0.8.0 // indirect
 	golang.org/x/text v0.19.0 // indirect
-	golang.org/x/tools v0.26.0 // indirect
 )
 
 replace github.com/hashicorp/terraform-provider-aws => ../
@@ -0,0 +1,832 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package introspect inspects the Go types of an AWS SDK for Go v2 service
+// package and describes the Terraform Plugin Framework resource that manages
+// the result of one of its Create operations.
+package introspect
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	sdkServicePackagePathPrefix = "github.com/aws/aws-sdk-go-v2/service/"
+	requiredMemberDoc           = "This member is required."
+)
+
+// Resource describes a Terraform resource derived from AWS SDK for Go v2 operations.
+type Resource struct {
+	Name              string // e.g. "Agent"
+	CreateOperation   string // e.g. "CreateAgent"
+	CreateOutputPath  string // Resource field in the Create operation's output, e.g. "Agent". Empty if the output is the resource.
+	ReadOperation     string // e.g. "GetAgent". Empty if there is no such operation.
+	ReadOutputPath    string // Resource field in the Read operation's output.
+	ReadOutputType    string // e.g. "awstypes.Agent" or "bedrockagent.GetAgentOutput"
+	UpdateOperation   string // e.g. "UpdateAgent". Empty if there is no such operation.
+	DeleteOperation   string // e.g. "DeleteAgent". Empty if there is no such operation.
+	ClientToken       string // Idempotency token field in the Create operation's input, e.g. "ClientToken".
+	UpdateClientToken string // Idempotency token field in the Update operation's input.
+	NotFoundError     string // Error type returned when the resource does not exist, e.g. "ResourceNotFoundException".
+	Identifier        *Identifier
+	List              *List
+	Status            *Status
+	Tags              bool
+	Model             *Model   // Resource model.
+	NestedModels      []*Model // Nested object models, in dependency order.
+}
+
+// Identifier describes the field that uniquely identifies a resource.
+type Identifier struct {
+	SDKName    string   // e.g. "AgentId"
+	ModelField string   // e.g. "AgentID"
+	Others     []string // Other required fields in the Read operation's input.
+}
+
+// List describes the operation used to enumerate resources, e.g. by sweepers.
+type List struct {
+	Operation  string // e.g. "ListAgents"
+	ItemsField string // e.g. "AgentSummaries"
+}
+
+// Status describes the resource field that reports its lifecycle state.
+type Status struct {
+	SDKName       string   // e.g. "AgentStatus"
+	CreatePending []string // Enum constant names, e.g. "AgentStatusCreating".
+	UpdatePending []string
+	DeletePending []string
+	Target        []string
+}
+
+// Model describes a model struct.
+type Model struct {
+	Name       string // e.g. "agentResourceModel"
+	Attributes []*Attribute
+	Blocks     []*Attribute
+	Computed   bool // All fields are Computed.
+}
+
+// Attribute describes a model struct field and its schema attribute or block.
+type Attribute struct {
+	ModelField         string // e.g. "AgentName"
+	TFName             string // e.g. "agent_name"
+	ValueType          string // e.g. "types.String"
+	SchemaType         string // e.g. "String", used to form schema.StringAttribute and planmodifier.String
+	CustomType         string // e.g. "fwtypes.ARNType"
+	ElementType        string // For List, Map and computed nested object attributes.
+	Required           bool
+	Optional           bool
+	Computed           bool
+	RequiresReplace    bool
+	UseStateForUnknown bool
+	MaxItems           int    // For blocks.
+	Nested             *Model // For blocks and computed nested object attributes.
+	TODO               string // Explanation of why the field could not be mapped.
+	TestValue          string // HCL value used in the generated acceptance test configuration.
+}
+
+// PlanModifierPackage returns the name of the plan modifier package for the attribute's schema type.
+func (a *Attribute) PlanModifierPackage() string {
+	return strings.ToLower(a.SchemaType) + "planmodifier"
+}
+
+// Load loads the AWS SDK for Go v2 service package, and its types package, from the Go module containing dir
+// and describes the resource created by the specified operation.
+func Load(dir, service, operation, resourceName string) (*Resource, error) {
+	if !strings.HasPrefix(operation, "Create") {
+		return nil, fmt.Errorf("operation (%s) is not a Create operation", operation)
+	}
+
+	servicePath := sdkServicePackagePathPrefix + service
+	typesPath := servicePath + "/types"
+
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedSyntax | packages.NeedImports | packages.NeedDeps,
+		Dir:  dir,
+	}
+	pkgs, err := packages.Load(cfg, servicePath, typesPath)
+	if err != nil {
+		return nil, fmt.Errorf("loading %s: %w", servicePath, err)
+	}
+
+	i := &inspector{
+		docs:     make(map[string]string),
+		models:   make(map[*types.Named]*Model),
+		visiting: make(map[*types.Named]bool),
+	}
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) > 0 {
+			return nil, fmt.Errorf("loading %s: %w", pkg.PkgPath, errors.Join(packageErrors(pkg.Errors)...))
+		}
+
+		switch pkg.PkgPath {
+		case servicePath:
+			i.service = pkg.Types
+		case typesPath:
+			i.types = pkg.Types
+		}
+		i.collectDocs(pkg)
+	}
+	if i.service == nil || i.types == nil {
+		return nil, fmt.Errorf("AWS SDK for Go v2 service package %s not found", servicePath)
+	}
+
+	if resourceName == "" {
+		resourceName = strings.TrimPrefix(operation, "Create")
+	}
+
+	return i.resource(operation, resourceName)
+}
+
+type inspector struct {
+	service  *types.Package
+	types    *types.Package
+	docs     map[string]string // Field doc comments keyed by "<package path>.<type name>.<field name>".
+	models   map[*types.Named]*Model
+	nested   []*Model
+	visiting map[*types.Named]bool
+}
+
+// collectDocs records the doc comments of the package's struct fields.
+func (i *inspector) collectDocs(pkg *packages.Package) {
+	for _, file := range pkg.Syntax {
+		ast.Inspect(file, func(n ast.Node) bool {
+			spec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			st, ok := spec.Type.(*ast.StructType)
+			if !ok {
+				return false
+			}
+			for _, field := range st.Fields.List {
+				if field.Doc == nil {
+					continue
+				}
+				for _, name := range field.Names {
+					i.docs[pkg.PkgPath+"."+spec.Name.Name+"."+name.Name] = field.Doc.Text()
+				}
+			}
+			return false
+		})
+	}
+}
+
+func (i *inspector) resource(operation, name string) (*Resource, error) {
+	createInput, err := i.serviceStruct(operation + "Input")
+	if err != nil {
+		return nil, err
+	}
+	createOutput, err := i.serviceStruct(operation + "Output")
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Resource{
+		Name:            name,
+		CreateOperation: operation,
+	}
+
+	// The resource's own type, e.g. "types.Agent", or the Create operation's output.
+	resourceStruct := createOutput
+	if v, ok := structField(createOutput, name); ok {
+		r.CreateOutputPath = name
+		resourceStruct = v
+	}
+
+	if op := "Get" + name; i.hasOperation(op) {
+		r.ReadOperation = op
+	} else if op := "Describe" + name; i.hasOperation(op) {
+		r.ReadOperation = op
+	}
+	if op := "Update" + name; i.hasOperation(op) {
+		r.UpdateOperation = op
+	}
+	if op := "Delete" + name; i.hasOperation(op) {
+		r.DeleteOperation = op
+	}
+
+	var updateInput *types.Struct
+	if r.UpdateOperation != "" {
+		updateInput, _ = i.serviceStruct(r.UpdateOperation + "Input")
+	}
+
+	for _, v := range []string{"ResourceNotFoundException", "NotFoundException"} {
+		if i.types.Scope().Lookup(v) != nil {
+			r.NotFoundError = v
+			break
+		}
+	}
+
+	if r.ReadOperation != "" {
+		readOutput, err := i.serviceStruct(r.ReadOperation + "Output")
+		if err != nil {
+			return nil, err
+		}
+		r.ReadOutputType = fmt.Sprintf("%s.%sOutput", i.service.Name(), r.ReadOperation)
+		if v, ok := structField(readOutput, name); ok {
+			r.ReadOutputPath = name
+			r.ReadOutputType = "awstypes." + namedType(fieldType(readOutput, name)).Obj().Name()
+			resourceStruct = v
+		}
+
+		readInput, err := i.serviceStruct(r.ReadOperation + "Input")
+		if err != nil {
+			return nil, err
+		}
+		r.Identifier = i.identifier(name, r.ReadOperation+"Input", readInput)
+	}
+
+	model := &Model{
+		Name: "resource" + name + "Model",
+	}
+
+	// Configurable attributes and blocks.
+	inputFields := make(map[string]bool)
+	for field := range fields(createInput) {
+		fieldName := field.Name()
+		inputFields[fieldName] = true
+
+		switch {
+		case slices.Contains([]string{"ClientToken", "ClientRequestToken", "IdempotencyToken"}, fieldName):
+			r.ClientToken = fieldName
+			if updateInput != nil && hasField(updateInput, fieldName) {
+				r.UpdateClientToken = fieldName
+			}
+			continue
+		case fieldName == "Tags":
+			r.Tags = true
+			continue
+		}
+
+		required := i.isRequired(i.service, operation+"Input", fieldName)
+		attr := i.attribute(name, fieldName, field.Type(), false)
+		attr.Required = required
+		attr.Optional = !required
+		attr.RequiresReplace = updateInput == nil || !hasField(updateInput, fieldName)
+		if !required && attr.Nested == nil && hasField(resourceStruct, fieldName) {
+			// The value may be defaulted by AWS.
+			attr.Computed = true
+			attr.UseStateForUnknown = true
+		}
+		model.add(attr)
+	}
+
+	// Computed attributes.
+	for field := range fields(resourceStruct) {
+		fieldName := field.Name()
+
+		if inputFields[fieldName] || fieldName == "Tags" {
+			continue
+		}
+
+		attr := i.attribute(name, fieldName, field.Type(), true)
+		attr.Computed = true
+		attr.UseStateForUnknown = attr.Nested == nil
+		model.add(attr)
+
+		if r.Status == nil && (fieldName == name+"Status" || fieldName == "Status") {
+			r.Status = i.status(fieldName, field.Type())
+		}
+	}
+
+	model.sort()
+	r.Model = model
+	r.NestedModels = i.nested
+
+	if r.Identifier != nil && !hasField(resourceStruct, r.Identifier.SDKName) {
+		// The identifier can't be read back from the resource.
+		r.Identifier = nil
+	}
+
+	r.List = i.list(name, r.Identifier)
+
+	return r, nil
+}
+
+// identifier returns the field that uniquely identifies the resource in the Read operation's input.
+func (i *inspector) identifier(name, readInputName string, readInput *types.Struct) *Identifier {
+	var required []string
+	for field := range fields(readInput) {
+		if i.isRequired(i.service, readInputName, field.Name()) {
+			required = append(required, field.Name())
+		}
+	}
+
+	if len(required) == 0 {
+		return nil
+	}
+
+	sdkName := required[0]
+	for _, v := range []string{name + "Id", "Id", name + "Arn", "Arn", name + "Name", "Name"} {
+		if slices.Contains(required, v) {
+			sdkName = v
+			break
+		}
+	}
+
+	id := &Identifier{
+		SDKName:    sdkName,
+		ModelField: modelFieldName(sdkName),
+	}
+	for _, v := range required {
+		if v != sdkName {
+			id.Others = append(id.Others, v)
+		}
+	}
+
+	return id
+}
+
+// list returns the paginated List operation for the resource.
+func (i *inspector) list(name string, id *Identifier) *List {
+	if id == nil {
+		return nil
+	}
+
+	for _, plural := range []string{name + "s", name + "es", strings.TrimSuffix(name, "y") + "ies"} {
+		op := "List" + plural
+		if i.service.Scope().Lookup("New"+op+"Paginator") == nil {
+			continue
+		}
+
+		output, err := i.serviceStruct(op + "Output")
+		if err != nil {
+			continue
+		}
+
+		for field := range fields(output) {
+			slice, ok := field.Type().(*types.Slice)
+			if !ok {
+				continue
+			}
+			item, ok := underlyingStruct(slice.Elem())
+			if !ok || !hasField(item, id.SDKName) {
+				continue
+			}
+
+			return &List{
+				Operation:  op,
+				ItemsField: field.Name(),
+			}
+		}
+	}
+
+	return nil
+}
+
+// status returns a description of the resource's status field, if it is an enum.
+func (i *inspector) status(fieldName string, typ types.Type) *Status {
+	named := namedType(typ)
+	if named == nil || !isEnum(named) {
+		return nil
+	}
+
+	s := &Status{
+		SDKName: fieldName,
+	}
+	for _, c := range enumConsts(named) {
+		value := strings.ToUpper(c.value)
+		switch {
+		case containsAny(value, "FAILED", "ERROR"):
+		case containsAny(value, "DELETING"):
+			s.DeletePending = append(s.DeletePending, c.name)
+		case containsAny(value, "CREATING", "PROVISIONING", "INITIALIZING", "STARTING"):
+			s.CreatePending = append(s.CreatePending, c.name)
+		case containsAny(value, "UPDATING", "MODIFYING", "PREPARING"):
+			s.UpdatePending = append(s.UpdatePending, c.name)
+		case containsAny(value, "PENDING", "IN_PROGRESS"):
+			s.CreatePending = append(s.CreatePending, c.name)
+			s.UpdatePending = append(s.UpdatePending, c.name)
+		case containsAny(value, "ACTIVE", "AVAILABLE", "READY", "CREATED", "COMPLETE", "SUCCEEDED", "ENABLED", "RUNNING", "IN_SERVICE", "PREPARED", "UPDATED"):
+			// e.g. "INACTIVE" or "UNAVAILABLE".
+			if !containsAny(value[:min(len(value), 2)], "IN", "UN") || strings.HasPrefix(value, "IN_SERVICE") {
+				s.Target = append(s.Target, c.name)
+			}
+		}
+	}
+
+	if len(s.Target) == 0 {
+		return nil
+	}
+
+	return s
+}
+
+// attribute maps an AWS SDK for Go v2 struct field to a model field and schema attribute or block.
+func (i *inspector) attribute(resourceName, fieldName string, typ types.Type, computed bool) *Attribute {
+	attr := &Attribute{
+		ModelField: modelFieldName(fieldName),
+		TFName:     convert.ToSnakeCase(fieldName, ""),
+	}
+
+	if resourceName != "" {
+		// Top-level resource attributes.
+		switch fieldName {
+		case resourceName + "Arn":
+			attr.TFName = "arn"
+		case resourceName + "Name":
+			attr.TFName = "name"
+		}
+	}
+
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+
+	switch typ := typ.(type) {
+	case *types.Basic:
+		i.basicAttribute(attr, fieldName, typ)
+		return attr
+
+	case *types.Slice:
+		elem := typ.Elem()
+		if ptr, ok := elem.(*types.Pointer); ok {
+			elem = ptr.Elem()
+		}
+
+		if isStringLike(elem) {
+			attr.SchemaType = "List"
+			attr.ElementType = "types.StringType"
+			attr.ValueType = "fwtypes.ListValueOf[types.String]"
+			attr.CustomType = "fwtypes.ListOfStringType"
+			attr.TestValue = `["TODO"]`
+			if strings.HasSuffix(fieldName, "Arns") {
+				attr.ElementType = "fwtypes.ARNType"
+				attr.ValueType = "fwtypes.ListValueOf[fwtypes.ARN]"
+				attr.CustomType = "fwtypes.ListOfARNType"
+			}
+			return attr
+		}
+
+		if named := namedType(elem); named != nil {
+			if _, ok := named.Underlying().(*types.Struct); ok {
+				i.nestedAttribute(attr, named, 0, computed)
+				return attr
+			}
+		}
+
+	case *types.Map:
+		if isStringLike(typ.Key()) && isStringLike(typ.Elem()) {
+			attr.SchemaType = "Map"
+			attr.ElementType = "types.StringType"
+			attr.ValueType = "fwtypes.MapValueOf[types.String]"
+			attr.CustomType = "fwtypes.MapOfStringType"
+			attr.TestValue = "{\n  key = \"TODO\"\n}"
+			return attr
+		}
+
+	case *types.Named:
+		obj := typ.Obj()
+		switch {
+		case obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Time":
+			attr.SchemaType = "String"
+			attr.ValueType = "timetypes.RFC3339"
+			attr.CustomType = "timetypes.RFC3339Type{}"
+			attr.TestValue = `"2006-01-02T15:04:05Z"`
+			return attr
+
+		case isEnum(typ):
+			attr.SchemaType = "String"
+			attr.ValueType = fmt.Sprintf("fwtypes.StringEnum[awstypes.%s]", obj.Name())
+			attr.CustomType = fmt.Sprintf("fwtypes.StringEnumType[awstypes.%s]()", obj.Name())
+			attr.TestValue = `"TODO"`
+			if cs := enumConsts(typ); len(cs) > 0 {
+				attr.TestValue = fmt.Sprintf("%q", cs[0].value)
+			}
+			return attr
+		}
+
+		switch u := typ.Underlying().(type) {
+		case *types.Basic:
+			i.basicAttribute(attr, fieldName, u)
+			return attr
+
+		case *types.Struct:
+			i.nestedAttribute(attr, typ, 1, computed)
+			return attr
+
+		case *types.Interface:
+			if obj.Pkg() == i.types {
+				attr.TODO = fmt.Sprintf("union type awstypes.%s: register its members with fwflex.RegisterUnionType", obj.Name())
+			} else {
+				attr.TODO = fmt.Sprintf("unsupported interface type %s", types.TypeString(typ, nil))
+			}
+			return attr
+		}
+	}
+
+	attr.TODO = fmt.Sprintf("unsupported type %s", types.TypeString(typ, nil))
+
+	return attr
+}
+
+func (i *inspector) basicAttribute(attr *Attribute, fieldName string, typ *types.Basic) {
+	switch info := typ.Info(); {
+	case info&types.IsString != 0:
+		attr.SchemaType = "String"
+		attr.ValueType = "types.String"
+		attr.TestValue = `"TODO"`
+		switch {
+		case strings.HasSuffix(fieldName, "Arn"):
+			attr.ValueType = "fwtypes.ARN"
+			attr.CustomType = "fwtypes.ARNType"
+		case attr.TFName == "name" || strings.HasSuffix(attr.TFName, "_name"):
+			attr.TestValue = "%[1]q"
+		}
+
+	case info&types.IsBoolean != 0:
+		attr.SchemaType = "Bool"
+		attr.ValueType = "types.Bool"
+		attr.TestValue = "false"
+
+	case info&types.IsInteger != 0:
+		attr.SchemaType = "Int64"
+		attr.ValueType = "types.Int64"
+		attr.TestValue = "1"
+
+	case info&types.IsFloat != 0:
+		attr.SchemaType = "Float64"
+		attr.ValueType = "types.Float64"
+		attr.TestValue = "1.0"
+
+	default:
+		attr.TODO = fmt.Sprintf("unsupported type %s", typ.Name())
+	}
+}
+
+// nestedAttribute maps a nested struct to a nested object model.
+// Configurable nested objects are blocks, computed nested objects are attributes.
+func (i *inspector) nestedAttribute(attr *Attribute, named *types.Named, maxItems int, computed bool) {
+	if i.visiting[named] {
+		attr.TODO = fmt.Sprintf("recursive type awstypes.%s", named.Obj().Name())
+		return
+	}
+
+	nested, ok := i.models[named]
+	if !ok {
+		nested = &Model{
+			Name:     convert.ToLowercasePrefix(named.Obj().Name()) + "Model",
+			Computed: computed,
+		}
+		i.models[named] = nested
+		i.visiting[named] = true
+
+		st := named.Underlying().(*types.Struct)
+		for field := range fields(st) {
+			fieldName := field.Name()
+			v := i.attribute("", fieldName, field.Type(), computed)
+			if computed {
+				v.Computed = true
+			} else {
+				v.Required = i.isRequired(named.Obj().Pkg(), named.Obj().Name(), fieldName)
+				v.Optional = !v.Required
+			}
+			nested.add(v)
+		}
+		nested.sort()
+
+		delete(i.visiting, named)
+		i.nested = append(i.nested, nested)
+	}
+
+	attr.SchemaType = "List"
+	attr.ValueType = fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", nested.Name)
+	attr.CustomType = fmt.Sprintf("fwtypes.NewListNestedObjectTypeOf[%s](ctx)", nested.Name)
+	attr.Nested = nested
+	attr.MaxItems = maxItems
+	if computed {
+		attr.ElementType = fmt.Sprintf("fwtypes.NewObjectTypeOf[%s](ctx)", nested.Name)
+	}
+}
+
+// isRequired returns whether the specified struct field is documented as required.
+func (i *inspector) isRequired(pkg *types.Package, typeName, fieldName string) bool {
+	return strings.Contains(i.docs[pkg.Path()+"."+typeName+"."+fieldName], requiredMemberDoc)
+}
+
+// hasOperation returns whether the service client has the specified operation method.
+func (i *inspector) hasOperation(operation string) bool {
+	obj := i.service.Scope().Lookup("Client")
+	if obj == nil {
+		return false
+	}
+
+	mset := types.NewMethodSet(types.NewPointer(obj.Type()))
+	return mset.Lookup(i.service, operation) != nil
+}
+
+// serviceStruct returns the struct type with the specified name in the service package.
+func (i *inspector) serviceStruct(name string) (*types.Struct, error) {
+	obj := i.service.Scope().Lookup(name)
+	if obj == nil {
+		return nil, fmt.Errorf("type %s.%s not found", i.service.Name(), name)
+	}
+
+	st, ok := obj.Type().Underlying().(*types.Struct)
+	if !ok {
+		return nil, fmt.Errorf("type %s.%s is not a struct", i.service.Name(), name)
+	}
+
+	return st, nil
+}
+
+func (m *Model) add(attr *Attribute) {
+	if attr.Nested != nil && !attr.Computed && !m.Computed {
+		m.Blocks = append(m.Blocks, attr)
+	} else {
+		m.Attributes = append(m.Attributes, attr)
+	}
+}
+
+// Fields returns the model's attributes and blocks, ordered by model field name.
+func (m *Model) Fields() []*Attribute {
+	fields := slices.Concat(m.Attributes, m.Blocks)
+	slices.SortFunc(fields, func(a, b *Attribute) int {
+		return strings.Compare(a.ModelField, b.ModelField)
+	})
+	return fields
+}
+
+func (m *Model) sort() {
+	f := func(a, b *Attribute) int {
+		return strings.Compare(a.TFName, b.TFName)
+	}
+	slices.SortFunc(m.Attributes, f)
+	slices.SortFunc(m.Blocks, f)
+}
+
+type enumConst struct {
+	name  string // e.g. "AgentStatusCreating"
+	value string // e.g. "CREATING"
+}
+
+// enumConsts returns the constants declared for an AWS SDK for Go v2 enum type, in declaration order.
+func enumConsts(named *types.Named) []enumConst {
+	pkg := named.Obj().Pkg()
+	if pkg == nil {
+		return nil
+	}
+
+	var cs []enumConst
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if !ok || !types.Identical(c.Type(), named) {
+			continue
+		}
+		cs = append(cs, enumConst{
+			name:  name,
+			value: strings.Trim(c.Val().ExactString(), `"`),
+		})
+	}
+
+	slices.SortStableFunc(cs, func(a, b enumConst) int {
+		return int(scope.Lookup(a.name).Pos() - scope.Lookup(b.name).Pos())
+	})
+
+	return cs
+}
+
+// isEnum returns whether the type is an AWS SDK for Go v2 enum, i.e. a string type with a Values method.
+func isEnum(named *types.Named) bool {
+	if basic, ok := named.Underlying().(*types.Basic); !ok || basic.Info()&types.IsString == 0 {
+		return false
+	}
+
+	for i := range named.NumMethods() {
+		if named.Method(i).Name() == "Values" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isStringLike(typ types.Type) bool {
+	basic, ok := typ.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsString != 0
+}
+
+// fields iterates over a struct's exported fields.
+func fields(st *types.Struct) func(func(*types.Var) bool) {
+	return func(yield func(*types.Var) bool) {
+		for i := range st.NumFields() {
+			field := st.Field(i)
+			if !field.Exported() || field.Embedded() {
+				continue
+			}
+			if !yield(field) {
+				return
+			}
+		}
+	}
+}
+
+func hasField(st *types.Struct, name string) bool {
+	for field := range fields(st) {
+		if field.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+func fieldType(st *types.Struct, name string) types.Type {
+	for field := range fields(st) {
+		if field.Name() == name {
+			return field.Type()
+		}
+	}
+	return nil
+}
+
+// structField returns the struct type of the specified struct (or pointer to struct) field.
+func structField(st *types.Struct, name string) (*types.Struct, bool) {
+	typ := fieldType(st, name)
+	if typ == nil {
+		return nil, false
+	}
+	return underlyingStruct(typ)
+}
+
+func underlyingStruct(typ types.Type) (*types.Struct, bool) {
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	st, ok := typ.Underlying().(*types.Struct)
+	return st, ok
+}
+
+func namedType(typ types.Type) *types.Named {
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	named, _ := typ.(*types.Named)
+	return named
+}
+
+func containsAny(s string, substrs ...string) bool {
+	return slices.ContainsFunc(substrs, func(substr string) bool {
+		return strings.Contains(s, substr)
+	})
+}
+
+var initialisms = map[string]string{
+	"Acl":  "ACL",
+	"Arn":  "ARN",
+	"Arns": "ARNs",
+	"Dns":  "DNS",
+	"Id":   "ID",
+	"Ids":  "IDs",
+	"Iam":  "IAM",
+	"Ip":   "IP",
+	"Json": "JSON",
+	"Kms":  "KMS",
+	"Sse":  "SSE",
+	"Ttl":  "TTL",
+	"Uri":  "URI",
+	"Url":  "URL",
+	"Vpc":  "VPC",
+}
+
+// modelFieldName returns the model struct field name for an AWS SDK for Go v2 field name,
+// e.g. "KmsKeyArn" becomes "KMSKeyARN". AutoFlex matches field names case-insensitively.
+func modelFieldName(name string) string {
+	var sb strings.Builder
+	var word strings.Builder
+	flush := func() {
+		w := word.String()
+		if v, ok := initialisms[w]; ok {
+			w = v
+		}
+		sb.WriteString(w)
+		word.Reset()
+	}
+
+	for i, r := range name {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			flush()
+		}
+		word.WriteRune(r)
+	}
+	flush()
+
+	return sb.String()
+}
+
+func packageErrors(errs []packages.Error) []error {
+	var v []error
+	for _, err := range errs {
+		v = append(v, err)
+	}
+	return v
+}
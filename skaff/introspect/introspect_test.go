@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package introspect
+
+import (
+	"reflect"
+	"testing"
+)
+
+// providerDir is the root of the provider's Go module, which requires the AWS SDK for Go v2 service packages.
+const providerDir = "../.."
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	r, err := Load(providerDir, "bedrockagent", "CreateAgent", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := r.Name, "Agent"; got != want {
+		t.Errorf("Name = %q, want %q", got, want)
+	}
+
+	operations := []struct {
+		name      string
+		got, want string
+	}{
+		{"CreateOperation", r.CreateOperation, "CreateAgent"},
+		{"CreateOutputPath", r.CreateOutputPath, "Agent"},
+		{"ReadOperation", r.ReadOperation, "GetAgent"},
+		{"ReadOutputPath", r.ReadOutputPath, "Agent"},
+		{"ReadOutputType", r.ReadOutputType, "awstypes.Agent"},
+		{"UpdateOperation", r.UpdateOperation, "UpdateAgent"},
+		{"DeleteOperation", r.DeleteOperation, "DeleteAgent"},
+		{"ClientToken", r.ClientToken, "ClientToken"},
+		{"NotFoundError", r.NotFoundError, "ResourceNotFoundException"},
+	}
+	for _, v := range operations {
+		if v.got != v.want {
+			t.Errorf("%s = %q, want %q", v.name, v.got, v.want)
+		}
+	}
+
+	if got, want := r.Identifier, (&Identifier{SDKName: "AgentId", ModelField: "AgentID"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Identifier = %+v, want %+v", got, want)
+	}
+
+	if got, want := r.List, (&List{Operation: "ListAgents", ItemsField: "AgentSummaries"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("List = %+v, want %+v", got, want)
+	}
+
+	wantStatus := &Status{
+		SDKName:       "AgentStatus",
+		CreatePending: []string{"AgentStatusCreating"},
+		UpdatePending: []string{"AgentStatusPreparing", "AgentStatusUpdating"},
+		DeletePending: []string{"AgentStatusDeleting"},
+		Target:        []string{"AgentStatusPrepared", "AgentStatusNotPrepared"},
+	}
+	if got := r.Status; !reflect.DeepEqual(got, wantStatus) {
+		t.Errorf("Status = %+v, want %+v", got, wantStatus)
+	}
+
+	if !r.Tags {
+		t.Error("Tags = false, want true")
+	}
+
+	if got, want := r.Model.Name, "resourceAgentModel"; got != want {
+		t.Errorf("Model.Name = %q, want %q", got, want)
+	}
+
+	attributes := make(map[string]*Attribute)
+	for _, v := range r.Model.Fields() {
+		attributes[v.TFName] = v
+	}
+
+	for _, tfName := range []string{"tags", "tags_all", "client_token"} {
+		if _, ok := attributes[tfName]; ok {
+			t.Errorf("unexpected attribute %q", tfName)
+		}
+	}
+
+	wantAttributes := map[string]Attribute{
+		"name": {
+			ModelField: "AgentName",
+			TFName:     "name",
+			ValueType:  "types.String",
+			SchemaType: "String",
+			Required:   true,
+			TestValue:  "%[1]q",
+		},
+		"agent_id": {
+			ModelField:         "AgentID",
+			TFName:             "agent_id",
+			ValueType:          "types.String",
+			SchemaType:         "String",
+			Computed:           true,
+			UseStateForUnknown: true,
+			TestValue:          `"TODO"`,
+		},
+		"arn": {
+			ModelField:         "AgentARN",
+			TFName:             "arn",
+			ValueType:          "fwtypes.ARN",
+			SchemaType:         "String",
+			CustomType:         "fwtypes.ARNType",
+			Computed:           true,
+			UseStateForUnknown: true,
+			TestValue:          `"TODO"`,
+		},
+		"agent_resource_role_arn": {
+			ModelField:         "AgentResourceRoleARN",
+			TFName:             "agent_resource_role_arn",
+			ValueType:          "fwtypes.ARN",
+			SchemaType:         "String",
+			CustomType:         "fwtypes.ARNType",
+			Optional:           true,
+			Computed:           true,
+			UseStateForUnknown: true,
+			TestValue:          `"TODO"`,
+		},
+		"agent_status": {
+			ModelField:         "AgentStatus",
+			TFName:             "agent_status",
+			ValueType:          "fwtypes.StringEnum[awstypes.AgentStatus]",
+			SchemaType:         "String",
+			CustomType:         "fwtypes.StringEnumType[awstypes.AgentStatus]()",
+			Computed:           true,
+			UseStateForUnknown: true,
+			TestValue:          `"CREATING"`,
+		},
+		"created_at": {
+			ModelField:         "CreatedAt",
+			TFName:             "created_at",
+			ValueType:          "timetypes.RFC3339",
+			SchemaType:         "String",
+			CustomType:         "timetypes.RFC3339Type{}",
+			Computed:           true,
+			UseStateForUnknown: true,
+			TestValue:          `"2006-01-02T15:04:05Z"`,
+		},
+		"failure_reasons": {
+			ModelField:         "FailureReasons",
+			TFName:             "failure_reasons",
+			ValueType:          "fwtypes.ListValueOf[types.String]",
+			SchemaType:         "List",
+			CustomType:         "fwtypes.ListOfStringType",
+			ElementType:        "types.StringType",
+			Computed:           true,
+			UseStateForUnknown: true,
+			TestValue:          `["TODO"]`,
+		},
+		"idle_session_ttl_in_seconds": {
+			ModelField:         "IdleSessionTTLInSeconds",
+			TFName:             "idle_session_ttl_in_seconds",
+			ValueType:          "types.Int64",
+			SchemaType:         "Int64",
+			Optional:           true,
+			Computed:           true,
+			UseStateForUnknown: true,
+			TestValue:          "1",
+		},
+	}
+	for tfName, want := range wantAttributes {
+		got, ok := attributes[tfName]
+		if !ok {
+			t.Errorf("attribute %q not found", tfName)
+			continue
+		}
+		if !reflect.DeepEqual(*got, want) {
+			t.Errorf("attribute %q = %+v, want %+v", tfName, *got, want)
+		}
+	}
+
+	blocks := make(map[string]*Attribute)
+	for _, v := range r.Model.Blocks {
+		blocks[v.TFName] = v
+	}
+	for _, tfName := range []string{"guardrail_configuration", "memory_configuration", "prompt_override_configuration"} {
+		v, ok := blocks[tfName]
+		if !ok {
+			t.Errorf("block %q not found", tfName)
+			continue
+		}
+		if v.SchemaType != "List" || v.MaxItems != 1 || !v.Optional || v.Nested == nil {
+			t.Errorf("block %q = %+v, want optional List with MaxItems 1 and a nested model", tfName, *v)
+		}
+	}
+
+	var nestedModels []string
+	for _, v := range r.NestedModels {
+		nestedModels = append(nestedModels, v.Name)
+	}
+	// Nested models are in dependency order.
+	if got, want := nestedModels, []string{
+		"guardrailConfigurationModel",
+		"memoryConfigurationModel",
+		"inferenceConfigurationModel",
+		"promptConfigurationModel",
+		"promptOverrideConfigurationModel",
+	}; !reflect.DeepEqual(got, want) {
+		t.Errorf("NestedModels = %v, want %v", got, want)
+	}
+}
+
+func TestLoadNotCreateOperation(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Load(providerDir, "bedrockagent", "GetAgent", ""); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
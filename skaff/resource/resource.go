@@ -16,6 +16,8 @@ import (
 
 	"github.com/hashicorp/terraform-provider-aws/names/data"
 	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/introspect"
+	"golang.org/x/tools/imports"
 )
 
 //go:embed resource.gtpl
@@ -27,6 +29,15 @@ var resourceFrameworkTmpl string
 //go:embed resourcetest.gtpl
 var resourceTestTmpl string
 
+//go:embed resourcesdk.gtpl
+var resourceSDKTmpl string
+
+//go:embed resourcesdktest.gtpl
+var resourceSDKTestTmpl string
+
+//go:embed sweepsdk.gtpl
+var sweepSDKTmpl string
+
 //go:embed websitedoc.gtpl
 var websiteTmpl string
 
@@ -45,9 +56,16 @@ type TemplateData struct {
 	PluginFramework      bool
 	HumanResourceName    string
 	ProviderResourceName string
+	SDKPackage           string               // AWS SDK for Go v2 service package, e.g. bedrockagent
+	SDK                  *introspect.Resource // Set when generating from an AWS SDK for Go v2 operation
+	SDKConfigBasic       string               // Acceptance test configuration body
+	SDKCreateIdentifier  string               // Expression identifying the resource before it is created
+	SDKHasARN            bool
+	SDKHasName           bool
+	SDKSweepFile         bool // The sweeper is generated into its own file
 }
 
-func Create(resName, snakeName string, comments, force, v2, pluginFramework, tags bool) error {
+func Create(resName, snakeName string, comments, force, v2, pluginFramework, tags bool, sdkOperation string) error {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
 		return fmt.Errorf("error reading working directory: %s", err)
@@ -55,6 +73,21 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 
 	servicePackage := filepath.Base(wd)
 
+	var sdkPackage string
+	if sdkOperation != "" {
+		if !v2 || !pluginFramework {
+			return fmt.Errorf("error checking: generating from an AWS SDK operation requires AWS Go SDK v2 and Terraform Plugin Framework")
+		}
+
+		if v, op, ok := strings.Cut(sdkOperation, "."); ok {
+			sdkPackage, sdkOperation = v, op
+		}
+
+		if resName == "" {
+			resName = strings.TrimPrefix(sdkOperation, "Create")
+		}
+	}
+
 	if resName == "" {
 		return fmt.Errorf("error checking: no name given")
 	}
@@ -91,6 +124,14 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 		ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
 	}
 
+	if sdkOperation != "" {
+		if sdkPackage == "" {
+			sdkPackage = service.GoV2Package()
+		}
+
+		return createFromSDK(wd, filepath.Join("..", "..", "..", "website", "docs", "r"), sdkPackage, sdkOperation, force, templateData)
+	}
+
 	tmpl := resourceTmpl
 	if pluginFramework {
 		tmpl = resourceFrameworkTmpl
@@ -124,7 +165,7 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 		return fmt.Errorf("error opening file (%s): %s", filename, err)
 	}
 
-	tplate, err := template.New(templateName).Parse(tmpl)
+	tplate, err := template.New(templateName).Funcs(templateFuncs).Parse(tmpl)
 	if err != nil {
 		return fmt.Errorf("error parsing template: %s", err)
 	}
@@ -135,13 +176,21 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 		return fmt.Errorf("error executing template: %s", err)
 	}
 
+	contents := buffer.Bytes()
+	if td.SDK != nil {
+		// Code generated from AWS SDK types is complete enough to have its imports fixed and be formatted.
+		if v, err := imports.Process(filename, contents, nil); err == nil {
+			contents = v
+		}
+	}
+
 	//contents, err := format.Source(buffer.Bytes())
 	//if err != nil {
 	//	return fmt.Errorf("error formatting generated file: %s", err)
 	//}
 
 	//if _, err := f.Write(contents); err != nil {
-	if _, err := f.Write(buffer.Bytes()); err != nil {
+	if _, err := f.Write(contents); err != nil {
 		f.Close() // ignore error; Write error takes precedence
 		return fmt.Errorf("error writing to file (%s): %s", filename, err)
 	}
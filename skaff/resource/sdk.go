@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/introspect"
+)
+
+var templateFuncs = template.FuncMap{
+	"contains": strings.Contains,
+	// enumSlice returns a Go expression for a slice of AWS SDK for Go v2 enum constants' string values.
+	"enumSlice": func(names ...[]string) string {
+		var consts []string
+		for _, v := range names {
+			for _, name := range v {
+				consts = append(consts, "awstypes."+name)
+			}
+		}
+		if len(consts) == 0 {
+			return "[]string{}"
+		}
+		return fmt.Sprintf("enum.Slice(%s)", strings.Join(consts, ", "))
+	},
+}
+
+// createFromSDK generates a resource, its acceptance tests and sweeper in the service package directory dir,
+// and its documentation in docsDir, from the AWS SDK for Go v2 types of the specified Create operation.
+func createFromSDK(dir, docsDir, sdkPackage, operation string, force bool, td TemplateData) error {
+	r, err := introspect.Load(dir, sdkPackage, operation, td.Resource)
+	if err != nil {
+		return fmt.Errorf("inspecting AWS SDK for Go v2 %s.%s: %w", sdkPackage, operation, err)
+	}
+
+	td.SDKPackage = sdkPackage
+	td.SDK = r
+	td.IncludeTags = td.IncludeTags || r.Tags
+	td.SDKCreateIdentifier = `""`
+	for _, v := range r.Model.Attributes {
+		switch v.TFName {
+		case "arn":
+			td.SDKHasARN = true
+		case "name":
+			td.SDKHasName = true
+			td.SDKCreateIdentifier = fmt.Sprintf("plan.%s.String()", v.ModelField)
+		}
+	}
+	td.SDKConfigBasic = configBasic(r.Model, "  ")
+
+	f := filepath.Join(dir, fmt.Sprintf("%s.go", td.ResourceSnake))
+	if err = writeTemplate("newres", f, resourceSDKTmpl, force, td); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	if r.ReadOperation != "" && r.Identifier != nil {
+		tf := filepath.Join(dir, fmt.Sprintf("%s_test.go", td.ResourceSnake))
+		if err = writeTemplate("restest", tf, resourceSDKTestTmpl, force, td); err != nil {
+			return fmt.Errorf("writing resource test template: %w", err)
+		}
+	}
+
+	if r.List != nil {
+		sf := filepath.Join(dir, "sweep.go")
+		if _, err := os.Stat(sf); !errors.Is(err, fs.ErrNotExist) {
+			// Don't overwrite the service package's existing sweepers.
+			sf = filepath.Join(dir, fmt.Sprintf("%s_sweep.go", td.ResourceSnake))
+			td.SDKSweepFile = true
+		}
+		if err = writeTemplate("sweep", sf, sweepSDKTmpl, force, td); err != nil {
+			return fmt.Errorf("writing sweeper template: %w", err)
+		}
+		if td.SDKSweepFile {
+			fmt.Printf("Register the sweeper in %s's RegisterSweepers function:\n\n\tawsv2.Register(%q, sweep%ss)\n\n", filepath.Join(dir, "sweep.go"), td.ProviderResourceName, td.Resource)
+		}
+	}
+
+	wf := filepath.Join(docsDir, fmt.Sprintf("%s_%s.html.markdown", td.ServicePackage, td.ResourceSnake))
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, td); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	fmt.Printf("Export the resource and its finder for acceptance tests in %s:\n\n\tResource%[2]s = newResource%[2]s\n\tFind%[2]sByID = find%[2]sByID\n\n", filepath.Join(dir, "exports_test.go"), td.Resource)
+
+	return nil
+}
+
+// configBasic returns the body of an acceptance test configuration that sets the model's required attributes and blocks.
+// String attributes that are names are set to the random name, `%[1]q`.
+func configBasic(m *introspect.Model, indent string) string {
+	var sb strings.Builder
+
+	for _, v := range m.Attributes {
+		if !v.Required || v.TODO != "" {
+			continue
+		}
+		fmt.Fprintf(&sb, "%s%s = %s\n", indent, v.TFName, strings.ReplaceAll(v.TestValue, "\n", "\n"+indent))
+	}
+
+	for _, v := range m.Blocks {
+		if !v.Required || v.TODO != "" {
+			continue
+		}
+		fmt.Fprintf(&sb, "\n%s%s {\n%s%s}\n", indent, v.TFName, configBasic(v.Nested, indent+"  "), indent)
+	}
+
+	return sb.String()
+}
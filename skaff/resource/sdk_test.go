@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+// TestCreateFromSDKCompiles generates a resource from an AWS SDK for Go v2 Create operation into a
+// temporary service package in the provider and checks that the generated code compiles.
+func TestCreateFromSDKCompiles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping compilation of generated code in short mode")
+	}
+
+	providerDir, err := filepath.Abs(filepath.Join("..", ".."))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The generated code imports the provider's internal packages, so it must be inside the provider's module.
+	dir, err := os.MkdirTemp(filepath.Join(providerDir, "internal", "service"), "skafftest")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	// Service packages get tagging functions from `go generate`.
+	tags := `package bedrockagent
+
+import "context"
+
+func getTagsIn(context.Context) map[string]string {
+	return nil
+}
+`
+	if err := os.WriteFile(filepath.Join(dir, "tags_gen.go"), []byte(tags), 0644); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	const (
+		resourceName   = "Agent"
+		servicePackage = "bedrockagent"
+	)
+	snakeName := convert.ToSnakeCase(resourceName, "")
+	td := TemplateData{
+		Resource:             resourceName,
+		ResourceLower:        "agent",
+		ResourceSnake:        snakeName,
+		HumanFriendlyService: "Agents for Amazon Bedrock",
+		ServicePackage:       servicePackage,
+		Service:              "BedrockAgent",
+		ServiceLower:         "bedrockagent",
+		AWSServiceName:       "Agents for Amazon Bedrock",
+		AWSGoSDKV2:           true,
+		PluginFramework:      true,
+		HumanResourceName:    convert.ToHumanResName(resourceName),
+		ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
+	}
+
+	if err := createFromSDK(dir, t.TempDir(), servicePackage, "CreateAgent", false, td); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, f := range []string{"agent.go", "agent_test.go", "sweep.go"} {
+		if _, err := os.Stat(filepath.Join(dir, f)); err != nil {
+			t.Errorf("generated file %s: %s", f, err)
+		}
+	}
+
+	// Acceptance tests refer to the resource's exports from the real service package, so only the resource and sweeper are compiled.
+	cmd := exec.Command("go", "build", "-o", os.DevNull, ".")
+	cmd.Dir = dir
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("compiling generated code: %s\n%s", err, output)
+	}
+}
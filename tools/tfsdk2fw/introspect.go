@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// funcName returns the name of the first non-nil top-level function, e.g. "resourceInstanceCreate".
+// Functions declared outside the specified package are qualified by their package name, e.g. "verify.SetTagsDiff".
+// Anonymous functions are ignored.
+func funcName(packageName string, fs ...any) string {
+	for _, f := range fs {
+		v := reflect.ValueOf(f)
+
+		if v.Kind() != reflect.Func || v.IsNil() {
+			continue
+		}
+
+		rf := runtime.FuncForPC(v.Pointer())
+
+		if rf == nil {
+			continue
+		}
+
+		// e.g. "github.com/hashicorp/terraform-provider-aws/internal/service/ec2.resourceInstanceCreate".
+		name := rf.Name()
+		name = name[strings.LastIndex(name, "/")+1:]
+		pkg, name, _ := strings.Cut(name, ".")
+
+		if name == "" || strings.Contains(name, ".") {
+			continue
+		}
+
+		if pkg != packageName {
+			return pkg + "." + name
+		}
+
+		return name
+	}
+
+	return ""
+}
+
+// packageFuncSet is the set of top-level function names declared in a Go package.
+type packageFuncSet []string
+
+// packageFuncs returns the names of the top-level functions declared in the non-test Go source files in the specified directory.
+// The generated output file is excluded.
+func packageFuncs(dirname, outputFilename string) (packageFuncSet, error) {
+	entries, err := os.ReadDir(dirname)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var funcs packageFuncSet
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		filename := path.Join(dirname, entry.Name())
+
+		if entry.IsDir() || !strings.HasSuffix(filename, ".go") || strings.HasSuffix(filename, "_test.go") || filename == path.Clean(outputFilename) {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filename, nil, parser.SkipObjectResolution)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, decl := range file.Decls {
+			if decl, ok := decl.(*ast.FuncDecl); ok && decl.Recv == nil {
+				funcs = append(funcs, decl.Name.Name)
+			}
+		}
+	}
+
+	slices.Sort(funcs)
+
+	return funcs, nil
+}
+
+// finder returns the name of the finder function for the specified resource, e.g. "findInstanceByID".
+func (fs packageFuncSet) finder(name string) string {
+	prefix := "find" + name
+
+	if v := prefix + "ByID"; slices.Contains(fs, v) {
+		return v
+	}
+
+	for _, v := range fs {
+		if strings.HasPrefix(v, prefix+"By") {
+			return v
+		}
+	}
+
+	if slices.Contains(fs, prefix) {
+		return prefix
+	}
+
+	return ""
+}
+
+// waiter returns the name of the waiter function for the specified resource and state, e.g. "waitInstanceCreated".
+func (fs packageFuncSet) waiter(name, state string) string {
+	if v := "wait" + name + state; slices.Contains(fs, v) {
+		return v
+	}
+
+	return ""
+}
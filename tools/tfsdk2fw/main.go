@@ -14,9 +14,12 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
 	"golang.org/x/exp/slices"
 )
@@ -58,16 +61,14 @@ func main() {
 		PackageName: packageName,
 	}
 
-	p, err := provider.New(context.Background())
-
-	if err != nil {
-		g.Fatalf(err.Error())
-	}
+	// Use the resources as registered by the service package.
+	// Those in the provider's ResourcesMap have their CRUD functions wrapped and the per-resource `region` argument injected.
+	servicePackages := provider.ServicePackages(context.Background())
 
 	if v := *dataSourceType; v != "" {
-		resource, ok := p.DataSourcesMap[v]
+		resource := sdkDataSource(servicePackages, v)
 
-		if !ok {
+		if resource == nil {
 			g.Fatalf("data source type %s not found", v)
 		}
 
@@ -76,14 +77,15 @@ func main() {
 		migrator.Template = datasourceImpl
 		migrator.TFTypeName = v
 	} else if v := *resourceType; v != "" {
-		resource, ok := p.ResourcesMap[v]
+		resource := sdkResource(servicePackages, v)
 
-		if !ok {
+		if resource == nil {
 			g.Fatalf("resource type %s not found", v)
 		}
 
 		migrator.Resource = resource
 		migrator.Template = resourceImpl
+		migrator.TestTemplate = resourceSchemaTestImpl
 		migrator.TFTypeName = v
 	}
 
@@ -92,6 +94,36 @@ func main() {
 	}
 }
 
+// sdkDataSource returns the specified Plugin SDK data source as registered by its service package.
+func sdkDataSource(servicePackages []conns.ServicePackage, typeName string) *schema.Resource {
+	ctx := context.Background()
+
+	for _, sp := range servicePackages {
+		for _, v := range sp.SDKDataSources(ctx) {
+			if v.TypeName == typeName {
+				return v.Factory()
+			}
+		}
+	}
+
+	return nil
+}
+
+// sdkResource returns the specified Plugin SDK resource as registered by its service package.
+func sdkResource(servicePackages []conns.ServicePackage, typeName string) *schema.Resource {
+	ctx := context.Background()
+
+	for _, sp := range servicePackages {
+		for _, v := range sp.SDKResources(ctx) {
+			if v.TypeName == typeName {
+				return v.Factory()
+			}
+		}
+	}
+
+	return nil
+}
+
 type migrator struct {
 	Generator    *common.Generator
 	IsDataSource bool
@@ -99,6 +131,7 @@ type migrator struct {
 	PackageName  string
 	Resource     *schema.Resource
 	Template     string
+	TestTemplate string
 	TFTypeName   string
 }
 
@@ -114,7 +147,7 @@ func (m *migrator) migrate(outputFilename string) error {
 		return fmt.Errorf("creating target directory %s: %w", dirname, err)
 	}
 
-	templateData, err := m.generateTemplateData()
+	templateData, err := m.generateTemplateData(dirname, outputFilename)
 
 	if err != nil {
 		return err
@@ -126,41 +159,138 @@ func (m *migrator) migrate(outputFilename string) error {
 		return err
 	}
 
+	if err := d.Write(); err != nil {
+		return err
+	}
+
+	if m.TestTemplate == "" {
+		return nil
+	}
+
+	// The schema equivalence test proves that the SDK and Framework schemas produce identical state.
+	testFilename := strings.TrimSuffix(outputFilename, ".go") + "_schema_test.go"
+
+	m.infof("generating schema equivalence test into %[1]q", testFilename)
+
+	d = m.Generator.NewGoFileDestination(testFilename)
+
+	if err := d.WriteTemplate("schematest", m.TestTemplate, templateData); err != nil {
+		return err
+	}
+
 	return d.Write()
 }
 
-func (m *migrator) generateTemplateData() (*templateData, error) {
+func (m *migrator) generateTemplateData(dirname, outputFilename string) (*templateData, error) {
+	// Record the SDK schema's type before the emitter makes any changes.
+	sdkSchemaType, err := m.Resource.CoreConfigSchema().ImpliedType().MarshalJSON()
+
+	if err != nil {
+		return nil, fmt.Errorf("marshaling SDK schema type: %w", err)
+	}
+
+	sbModels := strings.Builder{}
 	sbSchema := strings.Builder{}
 	sbStruct := strings.Builder{}
 	emitter := &emitter{
 		Generator:    m.Generator,
 		IsDataSource: m.IsDataSource,
+		ModelWriter:  &sbModels,
 		SchemaWriter: &sbSchema,
 		StructWriter: &sbStruct,
 	}
 
-	err := emitter.emitSchemaForResource(m.Resource)
+	err = emitter.emitSchemaForResource(m.Resource)
 
 	if err != nil {
 		return nil, fmt.Errorf("emitting schema code: %w", err)
 	}
 
+	var stateUpgraders []stateUpgrader
+	for _, v := range m.Resource.StateUpgraders {
+		sbPriorSchema := strings.Builder{}
+		emitter.SchemaWriter = &sbPriorSchema
+
+		if err := emitter.emitPriorSchema(v.Version, v.Type); err != nil {
+			return nil, fmt.Errorf("emitting version %d prior schema code: %w", v.Version, err)
+		}
+
+		stateUpgraders = append(stateUpgraders, stateUpgrader{
+			PriorSchema: sbPriorSchema.String(),
+			SDKUpgrade:  funcName(m.PackageName, v.Upgrade),
+			Version:     v.Version,
+		})
+	}
+
+	funcs, err := packageFuncs(dirname, outputFilename)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading package functions: %w", err)
+	}
+
+	providerNameUpper, err := names.ProviderNameUpper(m.PackageName)
+
+	if err != nil {
+		m.Generator.Warnf("%s", err)
+	}
+
+	humanFriendly, err := names.FullHumanFriendly(m.PackageName)
+
+	if err != nil {
+		humanFriendly = m.PackageName
+	}
+
 	templateData := &templateData{
 		DefaultCreateTimeout:         emitter.DefaultCreateTimeout,
 		DefaultReadTimeout:           emitter.DefaultReadTimeout,
 		DefaultUpdateTimeout:         emitter.DefaultUpdateTimeout,
 		DefaultDeleteTimeout:         emitter.DefaultDeleteTimeout,
 		EmitResourceImportState:      m.Resource.Importer != nil,
-		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
+		EmitResourceModifyPlan:       !m.IsDataSource && (emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap || m.Resource.CustomizeDiff != nil),
 		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
+		EmitResourceUpgradeState:     !m.IsDataSource && len(stateUpgraders) > 0,
+		Finder:                       funcs.finder(m.Name),
 		HasTimeouts:                  emitter.HasTimeouts,
+		HasTopLevelTags:              emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
+		HumanName:                    fmt.Sprintf("%s %s", humanFriendly, m.Name),
 		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
+		Models:                       sbModels.String(),
 		Name:                         m.Name,
 		PackageName:                  m.PackageName,
+		ProviderNameUpper:            providerNameUpper,
 		Schema:                       sbSchema.String(),
+		SchemaVersion:                m.Resource.SchemaVersion,
+		SDKCreate:                    funcName(m.PackageName, m.Resource.CreateWithoutTimeout, m.Resource.CreateContext, m.Resource.Create),
+		SDKCustomizeDiff:             funcName(m.PackageName, m.Resource.CustomizeDiff),
+		SDKDelete:                    funcName(m.PackageName, m.Resource.DeleteWithoutTimeout, m.Resource.DeleteContext, m.Resource.Delete),
+		SDKRead:                      funcName(m.PackageName, m.Resource.ReadWithoutTimeout, m.Resource.ReadContext, m.Resource.Read),
+		SDKSchemaType:                string(sdkSchemaType),
+		SDKUpdate:                    funcName(m.PackageName, m.Resource.UpdateWithoutTimeout, m.Resource.UpdateContext, m.Resource.Update),
+		StateUpgraders:               stateUpgraders,
 		Struct:                       sbStruct.String(),
 		TFTypeName:                   m.TFTypeName,
+		WaiterCreated:                funcs.waiter(m.Name, "Created"),
+		WaiterDeleted:                funcs.waiter(m.Name, "Deleted"),
+		WaiterUpdated:                funcs.waiter(m.Name, "Updated"),
+	}
+
+	if m.Resource.CustomizeDiff != nil && templateData.SDKCustomizeDiff == "" {
+		templateData.SDKCustomizeDiff = "CustomizeDiff"
+	}
+	if providerNameUpper == "" {
+		// Without a client there's nothing to pass to the finder and waiters.
+		templateData.Finder, templateData.WaiterCreated, templateData.WaiterDeleted, templateData.WaiterUpdated = "", "", "", ""
+	}
+	// Waiters take a timeout.
+	if templateData.DefaultCreateTimeout == 0 {
+		templateData.WaiterCreated = ""
+	}
+	if templateData.DefaultUpdateTimeout == 0 {
+		templateData.WaiterUpdated = ""
+	}
+	if templateData.DefaultDeleteTimeout == 0 {
+		templateData.WaiterDeleted = ""
 	}
 
 	for _, v := range emitter.FrameworkPlanModifierPackages {
@@ -201,8 +331,9 @@ type emitter struct {
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
 	IsDataSource                  bool
+	ModelWriter                   io.Writer // Nested block model structs.
 	SchemaWriter                  io.Writer
-	StructWriter                  io.Writer
+	StructWriter                  io.Writer // Fields of the current model struct.
 }
 
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
@@ -263,8 +394,6 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 // and emits the generated code to the emitter's Writer.
 // Property names are sorted prior to code generation to reduce diffs.
 func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*schema.Schema) error {
-	isTopLevelAttribute := len(path) == 0
-
 	// At this point we are emitting code for a schema.Block or Schema.
 	names := make([]string, 0)
 	for name := range schema {
@@ -287,9 +416,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 
 		fprintf(e.SchemaWriter, "%q:", name)
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		err := e.emitAttributeProperty(append(path, name), property)
 
@@ -297,9 +424,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			return err
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
-		}
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
 
 		fprintf(e.SchemaWriter, ",\n")
 	}
@@ -322,12 +447,16 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 
 		fprintf(e.SchemaWriter, "%q:", name)
 
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
+
 		err := e.emitBlockProperty(append(path, name), property)
 
 		if err != nil {
 			return err
 		}
 
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
+
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -355,9 +484,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		fprintf(e.StructWriter, "types.Bool")
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
@@ -365,9 +492,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		fprintf(e.StructWriter, "types.Float64")
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
@@ -375,9 +500,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		fprintf(e.StructWriter, "types.Int64")
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
@@ -390,9 +513,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			fprintf(e.StructWriter, "fwtypes.ARN")
 		} else {
 			if isTopLevelAttribute && attributeName == "id" {
 				fprintf(e.SchemaWriter, "// TODO framework.IDAttribute()\n")
@@ -400,9 +521,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			fprintf(e.StructWriter, "types.String")
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -419,9 +538,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.ListAttribute{"
 			typeName = "list"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
+			fprintf(e.StructWriter, "types.List")
 
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
@@ -432,9 +549,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.MapAttribute{"
 			typeName = "map"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
+			fprintf(e.StructWriter, "types.Map")
 
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
@@ -445,9 +560,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.SetAttribute{"
 			typeName = "set"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
+			fprintf(e.StructWriter, "types.Set")
 
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
@@ -632,17 +745,12 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "listvalidator"
 			fwValidatorType = "List"
 
-			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
-			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
-
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitNestedBlockObject(path, "List", v.Schema)
 
 			if err != nil {
 				return err
 			}
 
-			fprintf(e.SchemaWriter, "},\n")
-
 		default:
 			return unsupportedTypeError(path, fmt.Sprintf("(Block) list of %T", v))
 		}
@@ -655,17 +763,12 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
 
-			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
-			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
-
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitNestedBlockObject(path, "Set", v.Schema)
 
 			if err != nil {
 				return err
 			}
 
-			fprintf(e.SchemaWriter, "},\n")
-
 		default:
 			return unsupportedTypeError(path, fmt.Sprintf("(Block) set of %T", v))
 		}
@@ -730,6 +833,184 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 	return nil
 }
 
+// emitNestedBlockObject generates the Plugin Framework code, and the model struct, for a Plugin SDK Block's nested object
+// and emits the generated code to the emitter's Writers.
+func (e *emitter) emitNestedBlockObject(path []string, collectionType string, schema map[string]*schema.Schema) error {
+	modelName := naming.ToLowerCamelCase(strings.Join(path, "_")) + "Model"
+
+	e.ImportProviderFrameworkTypes = true
+
+	fprintf(e.StructWriter, "fwtypes.%sNestedObjectValueOf[%s]", collectionType, modelName)
+
+	fprintf(e.SchemaWriter, "schema.%sNestedBlock{\n", collectionType)
+	fprintf(e.SchemaWriter, "CustomType:fwtypes.New%sNestedObjectTypeOf[%s](ctx),\n", collectionType, modelName)
+	fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
+
+	// The nested object's attributes and blocks are the fields of its own model struct.
+	sbStruct := strings.Builder{}
+	structWriter := e.StructWriter
+	e.StructWriter = &sbStruct
+
+	err := e.emitAttributesAndBlocks(path, schema)
+
+	e.StructWriter = structWriter
+
+	if err != nil {
+		return err
+	}
+
+	fprintf(e.SchemaWriter, "},\n")
+
+	fprintf(e.ModelWriter, "type %s struct {\n%s}\n\n", modelName, sbStruct.String())
+
+	return nil
+}
+
+// emitPriorSchema generates the Plugin Framework code for a Plugin SDK StateUpgrader's prior schema type
+// and emits the generated code to the emitter's Writer.
+// All attributes are Optional as the prior schema is only used to decode existing state.
+// Attribute names are sorted prior to code generation to reduce diffs.
+func (e *emitter) emitPriorSchema(version int, typ cty.Type) error {
+	if !typ.IsObjectType() {
+		return fmt.Errorf("version %d state type is not an object: %s", version, typ.FriendlyName())
+	}
+
+	attributeTypes := typ.AttributeTypes()
+	names := make([]string, 0)
+	for name := range attributeTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fprintf(e.SchemaWriter, "schema.Schema{\n")
+	fprintf(e.SchemaWriter, "Version:%d,\n", version)
+	fprintf(e.SchemaWriter, "Attributes: map[string]schema.Attribute{\n")
+
+	for _, name := range names {
+		path := []string{name}
+		typ := attributeTypes[name]
+
+		fprintf(e.SchemaWriter, "%q:", name)
+
+		switch {
+		case typ == cty.Bool:
+			fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
+
+		case typ == cty.Number:
+			fprintf(e.SchemaWriter, "schema.NumberAttribute{\n")
+
+		case typ == cty.String:
+			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
+
+		case typ.IsListType(), typ.IsMapType(), typ.IsSetType():
+			var aggregateType string
+
+			switch {
+			case typ.IsListType():
+				aggregateType = "List"
+			case typ.IsMapType():
+				aggregateType = "Map"
+			case typ.IsSetType():
+				aggregateType = "Set"
+			}
+
+			elementType, err := e.priorSchemaAttrType(path, typ.ElementType())
+
+			if err != nil {
+				return err
+			}
+
+			fprintf(e.SchemaWriter, "schema.%sAttribute{\n", aggregateType)
+			fprintf(e.SchemaWriter, "ElementType:%s,\n", elementType)
+
+		case typ.IsObjectType():
+			attrType, err := e.priorSchemaAttrType(path, typ)
+
+			if err != nil {
+				return err
+			}
+
+			fprintf(e.SchemaWriter, "schema.ObjectAttribute{\n")
+			fprintf(e.SchemaWriter, "AttributeTypes:%s.AttrTypes,\n", attrType)
+
+		default:
+			return unsupportedTypeError(path, typ.FriendlyName())
+		}
+
+		fprintf(e.SchemaWriter, "Optional:true,\n")
+		fprintf(e.SchemaWriter, "},\n")
+	}
+
+	fprintf(e.SchemaWriter, "},\n")
+	fprintf(e.SchemaWriter, "}")
+
+	return nil
+}
+
+// priorSchemaAttrType returns the Plugin Framework attribute type for a Plugin SDK StateUpgrader's prior schema type.
+func (e *emitter) priorSchemaAttrType(path []string, typ cty.Type) (string, error) {
+	switch {
+	case typ == cty.Bool:
+		return "types.BoolType", nil
+
+	case typ == cty.Number:
+		return "types.NumberType", nil
+
+	case typ == cty.String:
+		return "types.StringType", nil
+
+	case typ.IsListType(), typ.IsMapType(), typ.IsSetType():
+		var aggregateType string
+
+		switch {
+		case typ.IsListType():
+			aggregateType = "types.ListType"
+		case typ.IsMapType():
+			aggregateType = "types.MapType"
+		case typ.IsSetType():
+			aggregateType = "types.SetType"
+		}
+
+		elementType, err := e.priorSchemaAttrType(path, typ.ElementType())
+
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s{ElemType:%s}", aggregateType, elementType), nil
+
+	case typ.IsObjectType():
+		attributeTypes := typ.AttributeTypes()
+		names := make([]string, 0)
+		for name := range attributeTypes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		e.ImportFrameworkAttr = true
+
+		sb := strings.Builder{}
+		fprintf(&sb, "types.ObjectType{\n")
+		fprintf(&sb, "AttrTypes: map[string]attr.Type{\n")
+		for _, name := range names {
+			attrType, err := e.priorSchemaAttrType(append(path, name), attributeTypes[name])
+
+			if err != nil {
+				return "", err
+			}
+
+			fprintf(&sb, "%q:%s,\n", name, attrType)
+		}
+		fprintf(&sb, "},\n")
+		fprintf(&sb, "}")
+
+		return sb.String(), nil
+
+	default:
+		return "", unsupportedTypeError(path, typ.FriendlyName())
+	}
+}
+
 // emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
 // and emits the generated code to the emitter's Writer.
 // See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
@@ -908,17 +1189,40 @@ type templateData struct {
 	EmitResourceImportState       bool
 	EmitResourceModifyPlan        bool
 	EmitResourceUpdateSkeleton    bool
+	EmitResourceUpgradeState      bool
+	Finder                        string // e.g. findInstanceByID
 	FrameworkPlanModifierPackages []string
 	FrameworkValidatorsPackages   []string
 	GoImports                     []goImport
 	HasTimeouts                   bool
+	HasTopLevelTags               bool
+	HumanName                     string // e.g. EC2 Instance
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	Models                        string
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
+	ProviderNameUpper             string // e.g. EC2
 	Schema                        string
+	SchemaVersion                 int
+	SDKCreate                     string // e.g. resourceInstanceCreate
+	SDKCustomizeDiff              string
+	SDKDelete                     string
+	SDKRead                       string
+	SDKSchemaType                 string // JSON
+	SDKUpdate                     string
+	StateUpgraders                []stateUpgrader
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
+	WaiterCreated                 string // e.g. waitInstanceCreated
+	WaiterDeleted                 string
+	WaiterUpdated                 string
+}
+
+type stateUpgrader struct {
+	PriorSchema string
+	SDKUpgrade  string // e.g. instanceStateUpgradeV0
+	Version     int
 }
 
 //go:embed datasource.gtpl
@@ -927,6 +1231,9 @@ var datasourceImpl string
 //go:embed resource.gtpl
 var resourceImpl string
 
+//go:embed resource_schema_test.gtpl
+var resourceSchemaTestImpl string
+
 type goImport struct {
 	Path  string
 	Alias string
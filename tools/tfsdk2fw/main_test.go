@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestGenerateTemplateDataResource(t *testing.T) {
+	t.Parallel()
+
+	const (
+		dirname = "../../internal/service/logs"
+	)
+
+	resource := sdkResource(provider.ServicePackages(context.Background()), "aws_cloudwatch_log_group")
+	if resource == nil {
+		t.Fatal("resource type not found")
+	}
+
+	m := &migrator{
+		Generator:    common.NewGenerator(),
+		Name:         "Group",
+		PackageName:  "logs",
+		Resource:     resource,
+		Template:     resourceImpl,
+		TestTemplate: resourceSchemaTestImpl,
+		TFTypeName:   "aws_cloudwatch_log_group",
+	}
+
+	templateData, err := m.generateTemplateData(dirname, dirname+"/group_fw.go")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, testCase := range []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"SDKCreate", templateData.SDKCreate, "resourceGroupCreate"},
+		{"SDKRead", templateData.SDKRead, "resourceGroupRead"},
+		{"SDKUpdate", templateData.SDKUpdate, "resourceGroupUpdate"},
+		{"SDKDelete", templateData.SDKDelete, "resourceGroupDelete"},
+		{"SDKCustomizeDiff", templateData.SDKCustomizeDiff, "verify.SetTagsDiff"},
+	} {
+		if testCase.got != testCase.expected {
+			t.Errorf("%s = %q, want %q", testCase.name, testCase.got, testCase.expected)
+		}
+	}
+
+	// The per-resource `region` argument is injected by the provider and must not be part of the generated resource.
+	if v := `"` + names.AttrRegion + `"`; strings.Contains(templateData.Schema, v) || strings.Contains(templateData.SDKSchemaType, v) {
+		t.Errorf("generated schema contains %s", v)
+	}
+}
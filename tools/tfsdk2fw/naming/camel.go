@@ -45,6 +45,26 @@ func ToCamelCase(s string) string {
 	return s
 }
 
+// ToLowerCamelCase converts a string to lowerCamelCase.
+// A leading initialism is lower-cased in its entirety, e.g. "arn_role" becomes "arnRole".
+func ToLowerCamelCase(s string) string {
+	b := []byte(ToCamelCase(s))
+
+	n := 0
+	for n < len(b) && isCapitalLetter(b[n]) {
+		n++
+	}
+	// Keep the capital letter that starts the following word, e.g. "IPAddress" becomes "ipAddress".
+	if n > 1 && n < len(b) && isLowercaseLetter(b[n]) {
+		n--
+	}
+	for i := 0; i < n; i++ {
+		b[i] = toLowercaseLetter(b[i])
+	}
+
+	return string(b)
+}
+
 func isCapitalLetter(ch byte) bool {
 	return ch >= 'A' && ch <= 'Z'
 }
@@ -62,3 +82,9 @@ func toCapitalLetter(ch byte) byte {
 	ch -= 'a'
 	return ch
 }
+
+func toLowercaseLetter(ch byte) byte {
+	ch += 'a'
+	ch -= 'A'
+	return ch
+}